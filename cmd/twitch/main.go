@@ -1,19 +1,45 @@
 package main
 
 import (
+	"context"
+	"database/sql"
+	"flag"
 	"fmt"
+	"log"
+	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
+	"time"
 
-	"github.com/ThyLeader/twitch-service/api"
-	"github.com/ThyLeader/twitch-service/twitch"
+	"github.com/coadler/twitch/internal/api"
+	apiv2 "github.com/coadler/twitch/internal/apiv2"
+	"github.com/coadler/twitch/twitch"
+	_ "github.com/lib/pq"
 	"github.com/spf13/viper"
 )
 
 var (
 	clientid       string
+	clientsecret   string
+	tokenurl       string
+	helixurl       string
+	postgres       string
+	storage        = "bolt"
 	apisecret      string
+	adminsecret    string
 	signsecret     string
 	updateinterval int64
+	tokenexpiry    time.Duration
+	livegrace      = twitch.DefaultLiveGrace
+	updatecooldown = twitch.DefaultUpdateCooldown
+	refreshpolls   = twitch.DefaultRefreshPolls
+	pollworkers    = twitch.DefaultPollWorkers
+	usercachettl   = twitch.DefaultUserCacheTTL
+	gamecachettl   = twitch.DefaultGameCacheTTL
+	missingttl     = twitch.DefaultMissingCacheTTL
+	// how long to wait for requests and messages to finish when stopping
+	shutdowntimeout = 30 * time.Second
 )
 
 func init() {
@@ -26,24 +52,210 @@ func init() {
 	}
 
 	clientid = viper.GetString("client-id")
+	clientsecret = viper.GetString("client-secret")
+	tokenurl = viper.GetString("token-url")
+	helixurl = viper.GetString("helix-url")
+	postgres = viper.GetString("postgres")
+	if s := viper.GetString("storage"); s != "" {
+		storage = s
+	}
 	apisecret = viper.GetString("api-secret")
+	adminsecret = viper.GetString("admin-secret")
 	signsecret = viper.GetString("sign-secret")
 	i := viper.GetString("update-interval")
 	updateinterval, err = strconv.ParseInt(i, 10, 64)
 	if err != nil {
 		panic(err.Error())
 	}
+
+	if e := viper.GetString("token-expiry"); e != "" {
+		tokenexpiry, err = time.ParseDuration(e)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+
+	if g := viper.GetString("live-grace"); g != "" {
+		livegrace, err = time.ParseDuration(g)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+
+	if c := viper.GetString("update-cooldown"); c != "" {
+		updatecooldown, err = time.ParseDuration(c)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+
+	if r := viper.GetString("refresh-polls"); r != "" {
+		refreshpolls, err = strconv.Atoi(r)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+
+	if w := viper.GetString("poll-workers"); w != "" {
+		pollworkers, err = strconv.Atoi(w)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+
+	if u := viper.GetString("user-cache-ttl"); u != "" {
+		usercachettl, err = time.ParseDuration(u)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+
+	if g := viper.GetString("game-cache-ttl"); g != "" {
+		gamecachettl, err = time.ParseDuration(g)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+
+	if m := viper.GetString("missing-cache-ttl"); m != "" {
+		missingttl, err = time.ParseDuration(m)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+
+	if s := viper.GetString("shutdown-timeout"); s != "" {
+		shutdowntimeout, err = time.ParseDuration(s)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
 }
 
+var repair = flag.Bool("repair", false, "recompute how many discord channels follow each twitch channel and exit")
+
 func main() {
+	flag.Parse()
+
+	var pg *sql.DB
+	if postgres != "" {
+		var err error
+		pg, err = sql.Open("postgres", postgres)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := pg.Ping(); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var db twitch.Storage
+	switch storage {
+	case "bolt":
+		db = twitch.NewDB()
+	case "postgres":
+		if pg == nil {
+			log.Fatal("postgres storage needs a postgres connection string")
+		}
+		db = twitch.NewPostgres(pg)
+		// closed along with the storage
+		pg = nil
+	default:
+		log.Fatalf("unknown storage %q", storage)
+	}
+
+	if *repair {
+		changed, err := db.RepairChannelCounts()
+		db.Close()
+		if pg != nil {
+			pg.Close()
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println("repaired", changed, "twitch channels")
+		return
+	}
+	twitchapi := twitch.NewAPI(clientid, db)
+	twitchapi.ClientSecret = clientsecret
+	if tokenurl != "" {
+		twitchapi.TokenURL = tokenurl
+	}
+	if helixurl != "" {
+		twitchapi.HelixURL = helixurl
+	}
+	twitchapi.LiveGrace = livegrace
+	twitchapi.UpdateCooldown = updatecooldown
+	twitchapi.RefreshPolls = refreshpolls
+	twitchapi.PollWorkers = pollworkers
+	twitchapi.UserCacheTTL = usercachettl
+	twitchapi.GameCacheTTL = gamecachettl
+	twitchapi.MissingCacheTTL = missingttl
+	twitchapi.UpdateInterval = time.Duration(updateinterval) * time.Second
+	// bolt storage can still cache users and games in postgres
+	if pg != nil {
+		twitchapi.Cache = twitch.NewPostgresCache(pg)
+	}
+
 	apiconfig := api.Config{
-		SignSecret: signsecret,
-		APISecret:  apisecret,
+		SignSecret:  signsecret,
+		APISecret:   apisecret,
+		AdminSecret: adminsecret,
+		TokenExpiry: tokenexpiry,
+		DB:          db,
+		Cache:       twitchapi.Cache,
 	}
 	restapi := api.New(apiconfig)
-	go restapi.Start(":1323")
+	go func() {
+		if err := restapi.Start(":1323"); err != nil {
+			log.Fatal(err)
+		}
+	}()
 
-	twitchapi := twitch.NewAPI(clientid)
-	db := twitch.NewDB()
-	twitchapi.Open(db, updateinterval)
+	grpcconfig := apiv2.Config{
+		SignSecret:  signsecret,
+		APISecret:   apisecret,
+		AdminSecret: adminsecret,
+		TokenExpiry: tokenexpiry,
+		DB:          db,
+		Cache:       twitchapi.Cache,
+		Events:      twitchapi.Events,
+	}
+	grpcapi := apiv2.New(grpcconfig)
+	go func() {
+		if err := grpcapi.Start(":1324"); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	go func() {
+		if err := twitchapi.Run(context.Background()); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
+
+	fmt.Println("shutting down")
+	ctx, cancel := context.WithTimeout(context.Background(), shutdowntimeout)
+	defer cancel()
+
+	// stop taking new subscriptions before waiting for messages to be sent
+	if err := restapi.Shutdown(ctx); err != nil {
+		fmt.Println("error shutting down rest api:", err.Error())
+	}
+	if err := grpcapi.Shutdown(ctx); err != nil {
+		fmt.Println("error shutting down grpc api:", err.Error())
+	}
+	// messages still being sent once ctx is done are cancelled, and
+	// Shutdown waits for them to stop so nothing uses the closed database
+	if err := twitchapi.Shutdown(ctx); err != nil {
+		fmt.Println("error waiting for messages to be sent:", err.Error())
+	}
+	db.Close()
+	if pg != nil {
+		pg.Close()
+	}
 }
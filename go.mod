@@ -1,5 +1,7 @@
 module github.com/coadler/twitch
 
+go 1.15
+
 require (
 	github.com/BurntSushi/toml v0.3.0 // indirect
 	github.com/ThyLeader/twitch-service v0.0.0-20180303221812-b64b6db5e234
@@ -1,6 +1,12 @@
 package api
 
 import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/coadler/twitch/internal/auth"
+	"github.com/coadler/twitch/twitch"
 	"github.com/labstack/echo"
 	"github.com/labstack/echo/middleware"
 )
@@ -8,17 +14,27 @@ import (
 // API ...
 type API struct {
 	router *echo.Echo
+	db     twitch.Storage
+	cache  twitch.Cache
 }
 
 // Config ...
 type Config struct {
 	SignSecret string
 	APISecret  string
+	// optional secret to get admin tokens with
+	AdminSecret string
+	TokenExpiry time.Duration
+	DB          twitch.Storage
+	// where users are invalidated from, DB unless set
+	Cache twitch.Cache
 }
 
 var (
 	signingsecret string
 	apisecret     string
+	adminsecret   string
+	issuer        *auth.Issuer
 )
 
 // New creates a new instance of the rest api
@@ -26,10 +42,21 @@ func New(config Config) *API {
 	if config.SignSecret == "" || config.APISecret == "" {
 		panic("api and signing secret not set")
 	}
+	if config.DB == nil {
+		panic("database not set")
+	}
 	signingsecret = config.SignSecret
 	apisecret = config.APISecret
+	adminsecret = config.AdminSecret
+	issuer = &auth.Issuer{
+		Secret: []byte(signingsecret),
+		Expiry: config.TokenExpiry,
+	}
 
-	api := &API{}
+	api := &API{db: config.DB, cache: config.Cache}
+	if api.cache == nil {
+		api.cache = config.DB
+	}
 	api.router = echo.New()
 
 	api.initAPI()
@@ -37,9 +64,19 @@ func New(config Config) *API {
 	return api
 }
 
-// Start starts the API
-func (a *API) Start(port string) {
-	a.router.Logger.Fatal(a.router.Start(port))
+// Start starts the API and blocks until it's shut down
+func (a *API) Start(port string) error {
+	err := a.router.Start(port)
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+// Shutdown stops accepting requests and waits for the ones
+// in progress to finish, until ctx is done
+func (a *API) Shutdown(ctx context.Context) error {
+	return a.router.Shutdown(ctx)
 }
 
 func (a *API) initAPI() {
@@ -50,17 +87,26 @@ func (a *API) initAPI() {
 	// Routes
 	a.router.GET("/", helloWorld)
 	a.router.POST("/v1/token", getToken)
+	a.router.POST("/v1/token/refresh", refreshToken)
+	a.router.GET("/v1/token/introspect", introspectToken)
 
 	v1 := a.router.Group("/v1/api")
 	config := middleware.JWTConfig{
-		Claims:     &jwtCustomClaims{},
+		Claims:     &auth.Claims{},
 		SigningKey: []byte(signingsecret),
 	}
 	v1.Use(middleware.JWTWithConfig(config))
+	v1.Use(requireName)
 	v1.GET("", checkAuth)
-	v1.GET("/webhooks/:channelid", getTwitchChannels)
-	v1.POST("/webhooks/:channelid/:twitchname", addWebhook)
-	v1.DELETE("/webhooks/:channelid/:twitchname/:webhookid", deleteWebhook)
+	v1.GET("/webhooks/:channelid", a.getTwitchChannels)
+	v1.POST("/webhooks/:channelid/:twitchname", a.addWebhook)
+	v1.DELETE("/webhooks/:channelid/:twitchname/:webhookid", a.deleteWebhook)
+	v1.GET("/options/:channelid", a.getChannelOptions)
+	v1.PUT("/options/:channelid", a.setChannelOptions)
+	v1.GET("/options/:channelid/:twitchname", a.getSubscriptionOptions)
+	v1.PUT("/options/:channelid/:twitchname", a.setSubscriptionOptions)
+	v1.GET("/shard/subscriptions", a.getShardSubscriptions)
+	v1.DELETE("/cache/users/:twitchname", a.invalidateUser)
 }
 
 // tentative routes
@@ -68,9 +114,17 @@ func (a *API) initAPI() {
 //                      UNAUTHENTICATED
 // GET 	/                                                   - hello world
 // POST /v1/token                                           - get jwt for auth
+// POST /v1/token/refresh                                   - exchange a jwt for a new one
+// GET 	/v1/token/introspect                                - get info about a jwt
 
 //                       AUTHENTICATED
 // GET 	/v1/api                                             - check jwt validity
 // GET 	/v1/api/webhooks/:channelid                         - returns a list of twitch channels for a specific channel
 // POST /v1/api/webhooks/:channelid/:twitchname             - make a new webhook
 // DEL 	/v1/api/webhooks/:channelid/:twitchname/:webhookid  - delete a webhook
+// GET 	/v1/api/options/:channelid                          - returns the notification settings for a specific channel
+// PUT 	/v1/api/options/:channelid                          - change the notification settings for a specific channel
+// GET 	/v1/api/options/:channelid/:twitchname              - returns the notification settings for a specific subscription
+// PUT 	/v1/api/options/:channelid/:twitchname              - change the notification settings for a specific subscription
+// GET 	/v1/api/shard/subscriptions                         - returns the subscriptions for the token's shard
+// DEL 	/v1/api/cache/users/:twitchname                     - forget the cached info of a twitch user
@@ -0,0 +1,209 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coadler/twitch/internal/auth"
+	"github.com/coadler/twitch/twitch"
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	signSecret = "sign secret"
+	apiSecret  = "api secret"
+	// tokens are issued with a short expiry so tests can skip past it
+	tokenExpiry = time.Minute
+)
+
+// newTestAPI returns the rest api backed by a new database
+func newTestAPI(t *testing.T) *API {
+	db, err := twitch.OpenDB(filepath.Join(t.TempDir(), "twitch.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(db.Close)
+
+	return New(Config{
+		SignSecret:  signSecret,
+		APISecret:   apiSecret,
+		TokenExpiry: tokenExpiry,
+		DB:          db,
+	})
+}
+
+// signToken signs a token for claims, bypassing the checks the token route makes
+func signToken(t *testing.T, claims *auth.Claims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(signSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+// skipTime makes tokens be validated as if d has passed
+func skipTime(t *testing.T, d time.Duration) {
+	jwt.TimeFunc = func() time.Time { return time.Now().Add(d) }
+	t.Cleanup(func() { jwt.TimeFunc = time.Now })
+}
+
+// do serves a request with the token as its bearer token, if it's set
+func (a *API) do(method, target, token string) *httptest.ResponseRecorder {
+	return a.doJSON(method, target, token, nil)
+}
+
+// doJSON is do with body sent as json, if it's set
+func (a *API) doJSON(method, target, token string, body interface{}) *httptest.ResponseRecorder {
+	var r io.Reader
+	if body != nil {
+		raw, _ := json.Marshal(body)
+		r = strings.NewReader(string(raw))
+	}
+
+	req := httptest.NewRequest(method, target, r)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	a.router.ServeHTTP(rec, req)
+	return rec
+}
+
+// decode fails the test if the response body isn't json decoding into v
+func decode(t *testing.T, rec *httptest.ResponseRecorder, v interface{}) {
+	t.Helper()
+	err := json.Unmarshal(rec.Body.Bytes(), v)
+	if err != nil {
+		t.Fatalf("decoding %q: %s", rec.Body, err)
+	}
+}
+
+// issueToken returns a token for the bot from the token route
+func (a *API) issueToken(t *testing.T, name string) string {
+	t.Helper()
+	rec := a.doJSON(http.MethodPost, "/v1/token", "", tokenRequest{Name: name, Secret: apiSecret})
+	expectStatus(t, rec, http.StatusOK)
+
+	var res struct {
+		Token string `json:"token"`
+	}
+	decode(t, rec, &res)
+	return res.Token
+}
+
+func expectStatus(t *testing.T, rec *httptest.ResponseRecorder, code int) {
+	t.Helper()
+	if rec.Code != code {
+		t.Fatalf("expected status %d, got %d (%s)", code, rec.Code, rec.Body)
+	}
+}
+
+func TestMissingName(t *testing.T) {
+	a := newTestAPI(t)
+
+	// subscriptions are scoped to the name, so it can't be missing
+	rec := a.do(http.MethodGet, "/v1/api/webhooks/1", signToken(t, &auth.Claims{}))
+	expectStatus(t, rec, http.StatusUnauthorized)
+
+	rec = a.do(http.MethodGet, "/v1/api/webhooks/1", signToken(t, &auth.Claims{Name: "bot"}))
+	expectStatus(t, rec, http.StatusOK)
+}
+
+func TestExpiredToken(t *testing.T) {
+	a := newTestAPI(t)
+	token := a.issueToken(t, "bot")
+	expectStatus(t, a.do(http.MethodGet, "/v1/api", token), http.StatusOK)
+
+	skipTime(t, tokenExpiry+time.Minute)
+	expectStatus(t, a.do(http.MethodGet, "/v1/api", token), http.StatusUnauthorized)
+}
+
+func TestRefreshToken(t *testing.T) {
+	a := newTestAPI(t)
+	token := a.issueToken(t, "bot")
+
+	rec := a.do(http.MethodPost, "/v1/token/refresh", token)
+	expectStatus(t, rec, http.StatusOK)
+	var res struct {
+		Token string `json:"token"`
+	}
+	decode(t, rec, &res)
+	expectStatus(t, a.do(http.MethodGet, "/v1/api", res.Token), http.StatusOK)
+
+	expectStatus(t, a.do(http.MethodPost, "/v1/token/refresh", "invalid"), http.StatusUnauthorized)
+
+	skipTime(t, tokenExpiry+time.Minute)
+	expectStatus(t, a.do(http.MethodPost, "/v1/token/refresh", token), http.StatusUnauthorized)
+}
+
+func TestIntrospectToken(t *testing.T) {
+	a := newTestAPI(t)
+	token := a.issueToken(t, "bot")
+
+	type introspection struct {
+		Active bool   `json:"active"`
+		Name   string `json:"name"`
+	}
+	introspect := func(token string) introspection {
+		t.Helper()
+		rec := a.do(http.MethodGet, "/v1/token/introspect", token)
+		expectStatus(t, rec, http.StatusOK)
+
+		var res introspection
+		decode(t, rec, &res)
+		return res
+	}
+
+	if res := introspect(token); !res.Active || res.Name != "bot" {
+		t.Fatalf("expected an active token for bot, got %+v", res)
+	}
+	if res := introspect("invalid"); res.Active || res.Name != "" {
+		t.Fatalf("expected an invalid token to be inactive without claims, got %+v", res)
+	}
+
+	// an expired token is inactive, but its claims are still reported
+	skipTime(t, tokenExpiry+time.Minute)
+	if res := introspect(token); res.Active || res.Name != "bot" {
+		t.Fatalf("expected an inactive token for bot, got %+v", res)
+	}
+}
+
+func TestSubscriptionOptions(t *testing.T) {
+	a := newTestAPI(t)
+	token := a.issueToken(t, "bot")
+	hook := map[string]string{"id": "hook", "token": "token"}
+	expectStatus(t, a.doJSON(http.MethodPost, "/v1/api/webhooks/1/streamer", token, hook), http.StatusCreated)
+	expectStatus(t, a.doJSON(http.MethodPost, "/v1/api/webhooks/1/other", token, hook), http.StatusCreated)
+
+	enable := map[string]bool{"stream_updates": true}
+	expectStatus(t, a.doJSON(http.MethodPut, "/v1/api/options/1/third", token, enable), http.StatusNotFound)
+	expectStatus(t, a.doJSON(http.MethodPut, "/v1/api/options/1/Streamer", token, enable), http.StatusOK)
+
+	// only the subscription it was set for gets stream updates
+	for name, enabled := range map[string]bool{"streamer": true, "other": false} {
+		rec := a.do(http.MethodGet, "/v1/api/options/1/"+name, token)
+		expectStatus(t, rec, http.StatusOK)
+
+		var opts twitch.SubscriptionOptions
+		decode(t, rec, &opts)
+		if opts.StreamUpdates != enabled {
+			t.Fatalf("expected stream updates for %s to be %t, got %t", name, enabled, opts.StreamUpdates)
+		}
+	}
+
+	// another bot can't see or change the options
+	other := a.issueToken(t, "other")
+	expectStatus(t, a.do(http.MethodGet, "/v1/api/options/1/streamer", other), http.StatusNotFound)
+	expectStatus(t, a.doJSON(http.MethodPut, "/v1/api/options/1/streamer", other, enable), http.StatusNotFound)
+}
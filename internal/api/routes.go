@@ -2,23 +2,25 @@ package api
 
 import (
 	"net/http"
-	"time"
+	"strings"
 
-	"github.com/ThyLeader/twitch-service/twitch"
+	"github.com/coadler/twitch/internal/auth"
+	"github.com/coadler/twitch/twitch"
 	"github.com/dgrijalva/jwt-go"
 	"github.com/labstack/echo"
 )
 
-type jwtCustomClaims struct {
-	Name  string `json:"name"`
-	Shard int    `json:"shard"`
-	jwt.StandardClaims
+type tokenRequest struct {
+	Name       string `json:"name" form:"name" query:"name"`
+	Shard      int    `json:"shard" form:"shard" query:"shard"`
+	ShardCount int    `json:"shard_count" form:"shard_count" query:"shard_count"`
+	Secret     string `json:"secret" form:"secret" query:"secret"`
 }
 
-type tokenRequest struct {
-	Name   string `json:"name" form:"name" query:"name"`
-	Shard  int    `json:"shard" form:"shard" query:"shard"`
-	Secret string `json:"secret" form:"secret" query:"secret"`
+type webhookRequest struct {
+	ID    string `json:"id" form:"id" query:"id"`
+	Token string `json:"token" form:"token" query:"token"`
+	Guild string `json:"guild" form:"guild" query:"guild"`
 }
 
 func helloWorld(c echo.Context) error {
@@ -31,59 +33,123 @@ func getToken(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest)
 	}
 
-	if r.Secret == apisecret {
-		// Set custom claims
-		claims := &jwtCustomClaims{
-			r.Name,
-			r.Shard,
-			jwt.StandardClaims{
-				ExpiresAt: time.Now().Add(time.Hour * 72).Unix(),
-			},
+	admin := adminsecret != "" && r.Secret == adminsecret
+	if r.Secret == apisecret || admin {
+		t, claims, err := issuer.Issue(auth.Claims{
+			Name:       r.Name,
+			Shard:      r.Shard,
+			ShardCount: r.ShardCount,
+			Admin:      admin,
+		})
+		if err == auth.ErrNoName {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 		}
-
-		// Create token with claims
-		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-		// Generate encoded token and send it as response.
-		t, err := token.SignedString([]byte(signingsecret))
 		if err != nil {
 			return err
 		}
 		return c.JSON(http.StatusOK, echo.Map{
-			"token": t,
+			"token":      t,
+			"expires_at": claims.ExpiresAt,
 		})
 	}
 
 	return echo.ErrUnauthorized
 }
 
+func refreshToken(c echo.Context) error {
+	t, claims, err := issuer.Refresh(bearerToken(c))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"token":      t,
+		"expires_at": claims.ExpiresAt,
+	})
+}
+
+func introspectToken(c echo.Context) error {
+	claims, err := auth.Parse(bearerToken(c), []byte(signingsecret))
+	if claims == nil {
+		return c.JSON(http.StatusOK, echo.Map{
+			"active": false,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"active":      err == nil,
+		"name":        claims.Name,
+		"shard":       claims.Shard,
+		"shard_count": claims.ShardCount,
+		"admin":       claims.Admin,
+		"issued_at":   claims.IssuedAt,
+		"expires_at":  claims.ExpiresAt,
+	})
+}
+
+// bearerToken returns the token from the authorization header
+func bearerToken(c echo.Context) string {
+	return strings.TrimPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+}
+
 func checkAuth(c echo.Context) error {
 	return c.String(http.StatusOK, "Authorized")
 }
 
-func getTwitchChannels(c echo.Context) error {
-	names, err := twitch.DB.GetTwitchNamesByChannel(c.Param("channelid"))
+// claims returns the claims of the jwt used to authenticate the request
+func claims(c echo.Context) *auth.Claims {
+	return c.Get("user").(*jwt.Token).Claims.(*auth.Claims)
+}
+
+// requireName rejects tokens without a bot name, since
+// subscriptions are scoped to the name they were added with
+func requireName(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if claims(c).Name == "" {
+			return echo.NewHTTPError(http.StatusUnauthorized, auth.ErrNoName.Error())
+		}
+		return next(c)
+	}
+}
+
+func (a *API) getTwitchChannels(c echo.Context) error {
+	cl := claims(c)
+	names, err := a.db.GetTwitchNamesByChannel(c.Param("channelid"), cl.Name, cl.Admin)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	var hook *twitch.Webhook
 	if names == nil {
 		names = []string{}
+	} else {
+		hook, err = a.db.GetWebhookByChannel(c.Param("channelid"), cl.Name, cl.Admin)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
 	}
 
 	return c.JSON(http.StatusOK, echo.Map{
-		"names": names,
+		"names":   names,
+		"webhook": hook,
 	})
 }
 
-func addWebhook(c echo.Context) error {
+func (a *API) addWebhook(c echo.Context) error {
 	channel, twitchName := c.Param("channelid"), c.Param("twitchname")
-	r := new(twitch.Webhook)
+	r := new(webhookRequest)
 	if err := c.Bind(r); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest)
 	}
 
-	err := twitch.DB.AddChannel(twitchName, channel, r)
+	hook := &twitch.Webhook{Channel: channel, ID: r.ID, Token: r.Token}
+	err := a.db.AddChannel(twitchName, channel, r.Guild, claims(c).Name, hook)
+	if err == twitch.ErrNotOwner {
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	}
+	if err == twitch.ErrInvalidGuild {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -93,13 +159,130 @@ func addWebhook(c echo.Context) error {
 	})
 }
 
-func deleteWebhook(c echo.Context) error {
+func (a *API) deleteWebhook(c echo.Context) error {
 	cID, tName, wID := c.Param("channelid"), c.Param("twitchname"), c.Param("webhookid")
 
-	err := twitch.DB.DeleteWebhook(tName, wID, cID)
+	cl := claims(c)
+	err := a.db.DeleteWebhook(tName, wID, cID, cl.Name, cl.Admin)
+	if err == twitch.ErrNotFound {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.String(http.StatusOK, "success")
+}
+
+func (a *API) getChannelOptions(c echo.Context) error {
+	cID, cl := c.Param("channelid"), claims(c)
+	names, err := a.db.GetTwitchNamesByChannel(cID, cl.Name, cl.Admin)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if len(names) < 1 {
+		return echo.NewHTTPError(http.StatusNotFound, twitch.ErrNotFound.Error())
+	}
+
+	opts, err := a.db.GetChannelOptions(cID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, opts)
+}
+
+func (a *API) setChannelOptions(c echo.Context) error {
+	opts := new(twitch.ChannelOptions)
+	if err := c.Bind(opts); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	cl := claims(c)
+	err := a.db.SetChannelOptions(c.Param("channelid"), cl.Name, cl.Admin, opts)
+	if err == twitch.ErrNotFound {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, opts)
+}
+
+func (a *API) getSubscriptionOptions(c echo.Context) error {
+	cID, twitchName, cl := c.Param("channelid"), c.Param("twitchname"), claims(c)
+	names, err := a.db.GetTwitchNamesByChannel(cID, cl.Name, cl.Admin)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if !tracks(names, twitchName) {
+		return echo.NewHTTPError(http.StatusNotFound, twitch.ErrNotFound.Error())
+	}
+
+	opts, err := a.db.GetSubscriptionOptions(twitchName, cID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, opts)
+}
+
+func (a *API) setSubscriptionOptions(c echo.Context) error {
+	opts := new(twitch.SubscriptionOptions)
+	if err := c.Bind(opts); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	cl := claims(c)
+	err := a.db.SetSubscriptionOptions(c.Param("twitchname"), c.Param("channelid"), cl.Name, cl.Admin, opts)
+	if err == twitch.ErrNotFound {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, opts)
+}
+
+func (a *API) getShardSubscriptions(c echo.Context) error {
+	cl := claims(c)
+	if cl.ShardCount < 1 {
+		return echo.NewHTTPError(http.StatusBadRequest, "token doesn't have a shard count")
+	}
+
+	subs, err := a.db.GetSubscriptionsByShard(cl.Shard, cl.ShardCount, cl.Name, cl.Admin)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if subs == nil {
+		subs = []*twitch.Subscription{}
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"subscriptions": subs,
+	})
+}
+
+func (a *API) invalidateUser(c echo.Context) error {
+	err := a.cache.InvalidateUser(c.Param("twitchname"))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	return c.String(http.StatusOK, "success")
 }
+
+// tracks returns whether names contains the twitch name, regardless of case
+func tracks(names []string, twitchName string) bool {
+	for _, e := range names {
+		if strings.EqualFold(e, twitchName) {
+			return true
+		}
+	}
+	return false
+}
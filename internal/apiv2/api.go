@@ -2,22 +2,536 @@ package api
 
 import (
 	"context"
+	"net"
+	"strings"
+	"time"
 
+	"github.com/coadler/twitch/internal/auth"
 	"github.com/coadler/twitch/pb"
+	"github.com/coadler/twitch/twitch"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
-var _ pb.TwitchServer = &twitch{}
+// API is the grpc counterpart to the rest api
+type API struct {
+	server *grpc.Server
+}
+
+// Config ...
+type Config struct {
+	SignSecret string
+	APISecret  string
+	// optional secret to get admin tokens with
+	AdminSecret string
+	TokenExpiry time.Duration
+	DB          twitch.Storage
+	// where users are invalidated from, DB unless set
+	Cache  twitch.Cache
+	Events *twitch.Events
+}
+
+// New creates a new instance of the grpc api
+func New(config Config) *API {
+	if config.SignSecret == "" || config.APISecret == "" {
+		panic("api and signing secret not set")
+	}
+	if config.DB == nil || config.Events == nil {
+		panic("database and events not set")
+	}
+
+	cache := config.Cache
+	if cache == nil {
+		cache = config.DB
+	}
+
+	api := &API{}
+	api.server = grpc.NewServer(
+		grpc.UnaryInterceptor(unaryAuthInterceptor([]byte(config.SignSecret))),
+		grpc.StreamInterceptor(streamAuthInterceptor([]byte(config.SignSecret))),
+	)
+	pb.RegisterTwitchServer(api.server, &service{
+		db:          config.DB,
+		cache:       cache,
+		events:      config.Events,
+		apiSecret:   config.APISecret,
+		adminSecret: config.AdminSecret,
+		issuer: &auth.Issuer{
+			Secret: []byte(config.SignSecret),
+			Expiry: config.TokenExpiry,
+		},
+	})
+
+	return api
+}
+
+// Start starts the API and blocks until it's shut down
+func (a *API) Start(port string) error {
+	lis, err := net.Listen("tcp", port)
+	if err != nil {
+		return err
+	}
+
+	return a.Serve(lis)
+}
+
+// Serve accepts incoming connections on the listener
+func (a *API) Serve(lis net.Listener) error {
+	err := a.server.Serve(lis)
+	if err == grpc.ErrServerStopped {
+		return nil
+	}
+	return err
+}
+
+// Shutdown stops accepting rpcs and waits for the ones in progress to
+// finish, until ctx is done when every rpc is cancelled
+func (a *API) Shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		a.server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		a.server.Stop()
+		return ctx.Err()
+	}
+}
+
+// unauthenticated are the methods that can be called without a token
+var unauthenticated = map[string]bool{
+	"/twitch.Twitch/IssueToken":      true,
+	"/twitch.Twitch/RefreshToken":    true,
+	"/twitch.Twitch/IntrospectToken": true,
+}
+
+// unaryAuthInterceptor rejects any call without a valid jwt in its
+// authorization metadata and puts the token's claims in the context
+func unaryAuthInterceptor(secret []byte) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if unauthenticated[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		claims, err := authenticate(ctx, secret)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(auth.NewContext(ctx, claims), req)
+	}
+}
+
+// streamAuthInterceptor is the streaming version of unaryAuthInterceptor
+func streamAuthInterceptor(secret []byte) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		claims, err := authenticate(ss.Context(), secret)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authStream{ss, auth.NewContext(ss.Context(), claims)})
+	}
+}
+
+// authStream overrides the context of a server stream
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate validates the token in the authorization metadata,
+// which can optionally be prefixed with "Bearer " like the rest api
+func authenticate(ctx context.Context, secret []byte) (*auth.Claims, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	header := md.Get("authorization")
+	if len(header) < 1 || header[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization token")
+	}
+
+	claims, err := auth.Parse(strings.TrimPrefix(header[0], "Bearer "), secret)
+	if err == auth.ErrExpired {
+		return nil, status.Error(codes.Unauthenticated, "token is expired")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	// subscriptions are scoped to the name, so a token without one can't be used
+	if claims.Name == "" {
+		return nil, status.Error(codes.Unauthenticated, auth.ErrNoName.Error())
+	}
+
+	return claims, nil
+}
+
+var _ pb.TwitchServer = &service{}
+
+type service struct {
+	db          twitch.Storage
+	cache       twitch.Cache
+	events      *twitch.Events
+	apiSecret   string
+	adminSecret string
+	issuer      *auth.Issuer
+}
+
+func (s *service) GetChannels(ctx context.Context, req *pb.GetChannelsRequest) (*pb.GetChannelsResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel id is required")
+	}
+
+	claims, _ := auth.FromContext(ctx)
+	names, err := s.db.GetTwitchNamesByChannel(req.Id, claims.Name, claims.Admin)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-type twitch struct{}
+	if len(names) < 1 {
+		return nil, status.Errorf(codes.NotFound, "no twitch channels tracked in %s", req.Id)
+	}
 
-func (t *twitch) GetChannels(context.Context, *pb.GetChannelsRequest) (*pb.GetChannelsResponse, error) {
-	panic("not implemented")
+	hook, err := s.db.GetWebhookByChannel(req.Id, claims.Name, claims.Admin)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &pb.GetChannelsResponse{Name: names}
+	if hook != nil {
+		res.Webhook = &pb.Webhook{Id: hook.ID, Token: hook.Token}
+	}
+
+	return res, nil
 }
 
-func (t *twitch) NewWebhook(context.Context, *pb.NewWebhookRequest) (*pb.NewWebhookResponse, error) {
-	panic("not implemented")
+func (s *service) NewWebhook(ctx context.Context, req *pb.NewWebhookRequest) (*pb.NewWebhookResponse, error) {
+	if req.Channel == "" || req.Twitchname == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel and twitchname are required")
+	}
+
+	claims, _ := auth.FromContext(ctx)
+	var hook *twitch.Webhook
+	if req.Webhook != nil {
+		if req.Webhook.Id == "" || req.Webhook.Token == "" {
+			return nil, status.Error(codes.InvalidArgument, "webhook id and token are required")
+		}
+		hook = &twitch.Webhook{Channel: req.Channel, ID: req.Webhook.Id, Token: req.Webhook.Token}
+	} else {
+		// no webhook was supplied, so reuse the one already registered
+		// for the discord channel by a subscription the bot can see
+		var err error
+		hook, err = s.db.GetWebhookByChannel(req.Channel, claims.Name, claims.Admin)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if hook == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "no webhook registered for %s, one must be supplied", req.Channel)
+		}
+	}
+
+	err := s.db.AddChannel(req.Twitchname, req.Channel, req.Guild, claims.Name, hook)
+	if err == twitch.ErrNotOwner {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err == twitch.ErrInvalidGuild {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.NewWebhookResponse{}, nil
+}
+
+func (s *service) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	if req.Channel == "" || req.Twitchname == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel and twitchname are required")
+	}
+
+	claims, _ := auth.FromContext(ctx)
+	err := s.db.DeleteWebhook(req.Twitchname, "", req.Channel, claims.Name, claims.Admin)
+	if err == twitch.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "%s is not tracked in %s", req.Twitchname, req.Channel)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.DeleteWebhookResponse{}, nil
+}
+
+func (s *service) WatchEvents(req *pb.WatchEventsRequest, stream pb.Twitch_WatchEventsServer) error {
+	if len(req.Channels) < 1 && len(req.Twitchnames) < 1 {
+		return status.Error(codes.InvalidArgument, "at least one channel or twitchname is required")
+	}
+
+	claims, _ := auth.FromContext(stream.Context())
+	w := s.events.Subscribe(claims.Name, claims.Admin, req.Channels, req.Twitchnames)
+	defer s.events.Unsubscribe(w)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+
+		case e, ok := <-w.Events():
+			if !ok {
+				return status.Error(codes.ResourceExhausted, w.Err().Error())
+			}
+
+			err := stream.Send(eventToPB(e))
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *service) GetChannelOptions(ctx context.Context, req *pb.GetChannelOptionsRequest) (*pb.ChannelOptions, error) {
+	if req.Channel == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel is required")
+	}
+
+	claims, _ := auth.FromContext(ctx)
+	names, err := s.db.GetTwitchNamesByChannel(req.Channel, claims.Name, claims.Admin)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if len(names) < 1 {
+		return nil, status.Errorf(codes.NotFound, "no channels tracked in %s", req.Channel)
+	}
+
+	opts, err := s.db.GetChannelOptions(req.Channel)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ChannelOptions{
+		OfflineSummary: opts.OfflineSummary,
+	}, nil
+}
+
+func (s *service) SetChannelOptions(ctx context.Context, req *pb.SetChannelOptionsRequest) (*pb.ChannelOptions, error) {
+	if req.Channel == "" || req.Options == nil {
+		return nil, status.Error(codes.InvalidArgument, "channel and options are required")
+	}
+
+	claims, _ := auth.FromContext(ctx)
+	opts := &twitch.ChannelOptions{
+		OfflineSummary: req.Options.OfflineSummary,
+	}
+	err := s.db.SetChannelOptions(req.Channel, claims.Name, claims.Admin, opts)
+	if err == twitch.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "no channels tracked in %s", req.Channel)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return req.Options, nil
+}
+
+func (s *service) GetSubscriptionOptions(ctx context.Context, req *pb.GetSubscriptionOptionsRequest) (*pb.SubscriptionOptions, error) {
+	if req.Channel == "" || req.Twitchname == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel and twitchname are required")
+	}
+
+	claims, _ := auth.FromContext(ctx)
+	names, err := s.db.GetTwitchNamesByChannel(req.Channel, claims.Name, claims.Admin)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !tracks(names, req.Twitchname) {
+		return nil, status.Errorf(codes.NotFound, "%s isn't tracked in %s", req.Twitchname, req.Channel)
+	}
+
+	opts, err := s.db.GetSubscriptionOptions(req.Twitchname, req.Channel)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.SubscriptionOptions{
+		StreamUpdates: opts.StreamUpdates,
+	}, nil
+}
+
+func (s *service) SetSubscriptionOptions(ctx context.Context, req *pb.SetSubscriptionOptionsRequest) (*pb.SubscriptionOptions, error) {
+	if req.Channel == "" || req.Twitchname == "" || req.Options == nil {
+		return nil, status.Error(codes.InvalidArgument, "channel, twitchname and options are required")
+	}
+
+	claims, _ := auth.FromContext(ctx)
+	opts := &twitch.SubscriptionOptions{
+		StreamUpdates: req.Options.StreamUpdates,
+	}
+	err := s.db.SetSubscriptionOptions(req.Twitchname, req.Channel, claims.Name, claims.Admin, opts)
+	if err == twitch.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "%s isn't tracked in %s", req.Twitchname, req.Channel)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return req.Options, nil
+}
+
+func (s *service) InvalidateUser(ctx context.Context, req *pb.InvalidateUserRequest) (*pb.InvalidateUserResponse, error) {
+	if req.Twitchname == "" {
+		return nil, status.Error(codes.InvalidArgument, "twitchname is required")
+	}
+
+	err := s.cache.InvalidateUser(req.Twitchname)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.InvalidateUserResponse{}, nil
+}
+
+func (s *service) GetShardSubscriptions(ctx context.Context, req *pb.GetShardSubscriptionsRequest) (*pb.GetShardSubscriptionsResponse, error) {
+	claims, _ := auth.FromContext(ctx)
+	if claims.ShardCount < 1 {
+		return nil, status.Error(codes.InvalidArgument, "token doesn't have a shard count")
+	}
+
+	subs, err := s.db.GetSubscriptionsByShard(claims.Shard, claims.ShardCount, claims.Name, claims.Admin)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &pb.GetShardSubscriptionsResponse{}
+	for _, e := range subs {
+		sub := &pb.Subscription{
+			Channel:    e.Channel,
+			Guild:      e.Guild,
+			Twitchname: e.TwitchName,
+		}
+		if e.Webhook != nil {
+			sub.Webhook = &pb.Webhook{Id: e.Webhook.ID, Token: e.Webhook.Token}
+		}
+		res.Subscriptions = append(res.Subscriptions, sub)
+	}
+
+	return res, nil
+}
+
+func (s *service) IssueToken(ctx context.Context, req *pb.IssueTokenRequest) (*pb.TokenResponse, error) {
+	admin := s.adminSecret != "" && req.Secret == s.adminSecret
+	if req.Secret != s.apiSecret && !admin {
+		return nil, status.Error(codes.Unauthenticated, "invalid api secret")
+	}
+
+	token, claims, err := s.issuer.Issue(auth.Claims{
+		Name:       req.Name,
+		Shard:      int(req.Shard),
+		ShardCount: int(req.ShardCount),
+		Admin:      admin,
+	})
+	if err == auth.ErrNoName {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.TokenResponse{Token: token, ExpiresAt: claims.ExpiresAt}, nil
+}
+
+func (s *service) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.TokenResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	token, claims, err := s.issuer.Refresh(req.Token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return &pb.TokenResponse{Token: token, ExpiresAt: claims.ExpiresAt}, nil
+}
+
+func (s *service) IntrospectToken(ctx context.Context, req *pb.IntrospectTokenRequest) (*pb.IntrospectTokenResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	claims, err := auth.Parse(req.Token, s.issuer.Secret)
+	if claims == nil {
+		return &pb.IntrospectTokenResponse{}, nil
+	}
+
+	return &pb.IntrospectTokenResponse{
+		Active:     err == nil,
+		Name:       claims.Name,
+		Shard:      int32(claims.Shard),
+		ShardCount: int32(claims.ShardCount),
+		Admin:      claims.Admin,
+		IssuedAt:   claims.IssuedAt,
+		ExpiresAt:  claims.ExpiresAt,
+	}, nil
+}
+
+func eventToPB(e *twitch.Event) *pb.Event {
+	event := &pb.Event{Channels: e.Channels}
+
+	switch e.Type {
+	case twitch.EventOnline:
+		event.Event = &pb.Event_Online{Online: &pb.StreamOnline{
+			Stream: streamToPB(e.Stream),
+		}}
+	case twitch.EventOffline:
+		event.Event = &pb.Event_Offline{Offline: &pb.StreamOffline{
+			Stream: streamToPB(e.Stream),
+		}}
+	case twitch.EventUpdated:
+		event.Event = &pb.Event_Updated{Updated: &pb.StreamUpdated{
+			Stream:   streamToPB(e.Stream),
+			Previous: streamToPB(e.Previous),
+		}}
+	}
+
+	return event
+}
+
+func streamToPB(c *twitch.ChannelData) *pb.Stream {
+	if c == nil {
+		return nil
+	}
+
+	return &pb.Stream{
+		Id:           c.ID,
+		UserId:       c.UserID,
+		Twitchname:   c.Login(),
+		GameId:       c.GameID,
+		Title:        c.Title,
+		Viewers:      int32(c.ViewerCount),
+		StartedAt:    c.StartedAt.Unix(),
+		ThumbnailUrl: c.ThumbnailURL,
+	}
 }
 
-func (t *twitch) DeleteWebhook(context.Context, *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
-	panic("not implemented")
+// tracks returns whether names contains the twitch name, regardless of case
+func tracks(names []string, twitchName string) bool {
+	for _, e := range names {
+		if strings.EqualFold(e, twitchName) {
+			return true
+		}
+	}
+	return false
 }
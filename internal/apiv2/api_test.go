@@ -0,0 +1,476 @@
+package api
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coadler/twitch/internal/auth"
+	"github.com/coadler/twitch/pb"
+	"github.com/coadler/twitch/twitch"
+	"github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	signSecret = "sign secret"
+	apiSecret  = "api secret"
+	// tokens are issued with a short expiry so tests can skip past it
+	tokenExpiry = time.Minute
+)
+
+// newTestClient serves the api over an in memory listener and returns a client for it
+func newTestClient(t *testing.T) pb.TwitchClient {
+	client, _ := newTestServer(t)
+	return client
+}
+
+// newTestServer is newTestClient that also returns where the api's events are published
+func newTestServer(t *testing.T) (pb.TwitchClient, *twitch.Events) {
+	db, err := twitch.OpenDB(filepath.Join(t.TempDir(), "twitch.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(db.Close)
+
+	events := twitch.NewEvents()
+	api := New(Config{
+		SignSecret:  signSecret,
+		APISecret:   apiSecret,
+		TokenExpiry: tokenExpiry,
+		DB:          db,
+		Events:      events,
+	})
+
+	lis := bufconn.Listen(1 << 20)
+	go api.Serve(lis)
+	t.Cleanup(api.server.Stop)
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithInsecure(),
+		grpc.WithDialer(func(string, time.Duration) (net.Conn, error) { return lis.Dial() }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewTwitchClient(conn), events
+}
+
+// authorize returns a context that calls rpcs as the given bot
+func authorize(t *testing.T, client pb.TwitchClient, name string) context.Context {
+	res, err := client.IssueToken(context.Background(), &pb.IssueTokenRequest{Name: name, Secret: apiSecret})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+res.Token)
+}
+
+// withToken returns a context that calls rpcs with a token signed for claims,
+// bypassing the checks IssueToken makes
+func withToken(t *testing.T, claims *auth.Claims) context.Context {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(signSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+// skipTime makes tokens be validated as if d has passed
+func skipTime(t *testing.T, d time.Duration) {
+	jwt.TimeFunc = func() time.Time { return time.Now().Add(d) }
+	t.Cleanup(func() { jwt.TimeFunc = time.Now })
+}
+
+func expectCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if s := status.Code(err); s != code {
+		t.Fatalf("expected %s, got %s (%v)", code, s, err)
+	}
+}
+
+func TestUnauthenticated(t *testing.T) {
+	client := newTestClient(t)
+	invalid := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer invalid")
+	// subscriptions are scoped to the name, so it can't be missing
+	noName := withToken(t, &auth.Claims{})
+
+	for _, ctx := range []context.Context{context.Background(), invalid, noName} {
+		_, err := client.GetChannels(ctx, &pb.GetChannelsRequest{Id: "1"})
+		expectCode(t, err, codes.Unauthenticated)
+
+		_, err = client.NewWebhook(ctx, &pb.NewWebhookRequest{
+			Channel:    "1",
+			Twitchname: "streamer",
+			Webhook:    &pb.Webhook{Id: "hook", Token: "token"},
+		})
+		expectCode(t, err, codes.Unauthenticated)
+
+		_, err = client.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{Channel: "1", Twitchname: "streamer"})
+		expectCode(t, err, codes.Unauthenticated)
+
+		stream, err := client.WatchEvents(ctx, &pb.WatchEventsRequest{Twitchnames: []string{"streamer"}})
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		expectCode(t, err, codes.Unauthenticated)
+	}
+
+	_, err := client.IssueToken(context.Background(), &pb.IssueTokenRequest{Name: "bot", Secret: "wrong"})
+	expectCode(t, err, codes.Unauthenticated)
+}
+
+func TestInvalidArgument(t *testing.T) {
+	client := newTestClient(t)
+	ctx := authorize(t, client, "bot")
+
+	_, err := client.GetChannels(ctx, &pb.GetChannelsRequest{})
+	expectCode(t, err, codes.InvalidArgument)
+
+	for _, req := range []*pb.NewWebhookRequest{
+		{Twitchname: "streamer", Webhook: &pb.Webhook{Id: "hook", Token: "token"}},
+		{Channel: "1", Webhook: &pb.Webhook{Id: "hook", Token: "token"}},
+		{Channel: "1", Twitchname: "streamer", Webhook: &pb.Webhook{Id: "hook"}},
+		// there's no webhook to reuse yet
+		{Channel: "1", Twitchname: "streamer"},
+		{Channel: "1", Twitchname: "streamer", Webhook: &pb.Webhook{Id: "hook", Token: "token"}, Guild: "guild"},
+	} {
+		_, err = client.NewWebhook(ctx, req)
+		expectCode(t, err, codes.InvalidArgument)
+	}
+
+	_, err = client.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{Channel: "1"})
+	expectCode(t, err, codes.InvalidArgument)
+	_, err = client.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{Twitchname: "streamer"})
+	expectCode(t, err, codes.InvalidArgument)
+}
+
+func TestNotFound(t *testing.T) {
+	client := newTestClient(t)
+	ctx := authorize(t, client, "bot")
+
+	_, err := client.GetChannels(ctx, &pb.GetChannelsRequest{Id: "1"})
+	expectCode(t, err, codes.NotFound)
+
+	_, err = client.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{Channel: "1", Twitchname: "streamer"})
+	expectCode(t, err, codes.NotFound)
+}
+
+func TestWebhooks(t *testing.T) {
+	client := newTestClient(t)
+	ctx := authorize(t, client, "bot")
+	other := authorize(t, client, "other")
+
+	_, err := client.NewWebhook(ctx, &pb.NewWebhookRequest{
+		Channel:    "1",
+		Twitchname: "Streamer",
+		Webhook:    &pb.Webhook{Id: "hook", Token: "token"},
+		Guild:      "173184118492889089",
+	})
+	expectCode(t, err, codes.OK)
+
+	// the webhook is reused when none is supplied
+	_, err = client.NewWebhook(ctx, &pb.NewWebhookRequest{Channel: "1", Twitchname: "other"})
+	expectCode(t, err, codes.OK)
+
+	res, err := client.GetChannels(ctx, &pb.GetChannelsRequest{Id: "1"})
+	expectCode(t, err, codes.OK)
+	if len(res.Name) != 2 {
+		t.Fatalf("expected 2 twitch channels, got %v", res.Name)
+	}
+	if res.Webhook == nil || res.Webhook.Id != "hook" || res.Webhook.Token != "token" {
+		t.Fatalf("expected the channel's webhook, got %v", res.Webhook)
+	}
+
+	// another bot can't see, reuse, take over or delete the subscriptions
+	_, err = client.GetChannels(other, &pb.GetChannelsRequest{Id: "1"})
+	expectCode(t, err, codes.NotFound)
+	_, err = client.NewWebhook(other, &pb.NewWebhookRequest{Channel: "1", Twitchname: "third"})
+	expectCode(t, err, codes.InvalidArgument)
+	_, err = client.NewWebhook(other, &pb.NewWebhookRequest{
+		Channel:    "1",
+		Twitchname: "streamer",
+		Webhook:    &pb.Webhook{Id: "hook", Token: "token"},
+	})
+	expectCode(t, err, codes.PermissionDenied)
+	_, err = client.DeleteWebhook(other, &pb.DeleteWebhookRequest{Channel: "1", Twitchname: "streamer"})
+	expectCode(t, err, codes.NotFound)
+
+	_, err = client.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{Channel: "1", Twitchname: "streamer"})
+	expectCode(t, err, codes.OK)
+	_, err = client.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{Channel: "1", Twitchname: "streamer"})
+	expectCode(t, err, codes.NotFound)
+
+	res, err = client.GetChannels(ctx, &pb.GetChannelsRequest{Id: "1"})
+	expectCode(t, err, codes.OK)
+	if len(res.Name) != 1 || res.Name[0] != "other" {
+		t.Fatalf("expected only other to be tracked, got %v", res.Name)
+	}
+
+	_, err = client.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{Channel: "1", Twitchname: "other"})
+	expectCode(t, err, codes.OK)
+	_, err = client.GetChannels(ctx, &pb.GetChannelsRequest{Id: "1"})
+	expectCode(t, err, codes.NotFound)
+}
+
+func TestSubscriptionOptions(t *testing.T) {
+	client := newTestClient(t)
+	ctx := authorize(t, client, "bot")
+	other := authorize(t, client, "other")
+
+	for _, name := range []string{"streamer", "other"} {
+		_, err := client.NewWebhook(ctx, &pb.NewWebhookRequest{
+			Channel:    "1",
+			Twitchname: name,
+			Webhook:    &pb.Webhook{Id: "hook", Token: "token"},
+		})
+		expectCode(t, err, codes.OK)
+	}
+
+	_, err := client.SetSubscriptionOptions(ctx, &pb.SetSubscriptionOptionsRequest{Channel: "1", Twitchname: "streamer"})
+	expectCode(t, err, codes.InvalidArgument)
+	_, err = client.SetSubscriptionOptions(ctx, &pb.SetSubscriptionOptionsRequest{
+		Channel:    "1",
+		Twitchname: "third",
+		Options:    &pb.SubscriptionOptions{StreamUpdates: true},
+	})
+	expectCode(t, err, codes.NotFound)
+
+	_, err = client.SetSubscriptionOptions(ctx, &pb.SetSubscriptionOptionsRequest{
+		Channel:    "1",
+		Twitchname: "Streamer",
+		Options:    &pb.SubscriptionOptions{StreamUpdates: true},
+	})
+	expectCode(t, err, codes.OK)
+
+	// only the subscription it was set for gets stream updates
+	for name, enabled := range map[string]bool{"streamer": true, "other": false} {
+		res, err := client.GetSubscriptionOptions(ctx, &pb.GetSubscriptionOptionsRequest{Channel: "1", Twitchname: name})
+		expectCode(t, err, codes.OK)
+		if res.StreamUpdates != enabled {
+			t.Fatalf("expected stream updates for %s to be %t, got %t", name, enabled, res.StreamUpdates)
+		}
+	}
+
+	// another bot can't see or change the options
+	_, err = client.GetSubscriptionOptions(other, &pb.GetSubscriptionOptionsRequest{Channel: "1", Twitchname: "streamer"})
+	expectCode(t, err, codes.NotFound)
+	_, err = client.SetSubscriptionOptions(other, &pb.SetSubscriptionOptionsRequest{
+		Channel:    "1",
+		Twitchname: "streamer",
+		Options:    &pb.SubscriptionOptions{},
+	})
+	expectCode(t, err, codes.NotFound)
+}
+
+// waitWatched fails the test if events doesn't become watched, or unwatched, within a few seconds
+func waitWatched(t *testing.T, events *twitch.Events, watched bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for events.Watched() != watched {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for watched to be %v", watched)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestWatchEvents(t *testing.T) {
+	client, events := newTestServer(t)
+	ctx := authorize(t, client, "bot")
+
+	stream, err := client.WatchEvents(ctx, &pb.WatchEventsRequest{})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	expectCode(t, err, codes.InvalidArgument)
+
+	for _, e := range []struct {
+		name string
+		req  *pb.WatchEventsRequest
+	}{
+		{"channel", &pb.WatchEventsRequest{Channels: []string{"1"}}},
+		{"twitch login", &pb.WatchEventsRequest{Twitchnames: []string{"Streamer"}}},
+	} {
+		t.Run(e.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			stream, err := client.WatchEvents(ctx, e.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			waitWatched(t, events, true)
+
+			// events are sent in order, so getting the second means the first was filtered out
+			events.Publish(&twitch.Event{Type: twitch.EventOnline, Login: "other", Channels: []string{"2"}})
+			events.Publish(&twitch.Event{
+				Type:     twitch.EventOffline,
+				Login:    "streamer",
+				Channels: []string{"1"},
+				Stream:   &twitch.ChannelData{ID: "stream", UserLogin: "streamer"},
+			})
+
+			event, err := stream.Recv()
+			if err != nil {
+				t.Fatal(err)
+			}
+			offline := event.GetOffline()
+			if offline == nil || offline.Stream.Twitchname != "streamer" {
+				t.Fatalf("expected streamer to go offline, got %v", event)
+			}
+			if len(event.Channels) != 1 || event.Channels[0] != "1" {
+				t.Fatalf("expected the event for channel 1, got %v", event.Channels)
+			}
+
+			// the watcher is unsubscribed once the client goes away
+			cancel()
+			waitWatched(t, events, false)
+		})
+	}
+}
+
+// blockedStream is a WatchEvents stream whose sends block until it's released
+type blockedStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	release chan struct{}
+}
+
+func (s *blockedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *blockedStream) Send(*pb.Event) error {
+	<-s.release
+	return nil
+}
+
+func TestWatchEventsSlowConsumer(t *testing.T) {
+	events := twitch.NewEvents()
+	svc := &service{events: events}
+	stream := &blockedStream{
+		ctx:     auth.NewContext(context.Background(), &auth.Claims{Name: "bot"}),
+		release: make(chan struct{}),
+	}
+
+	res := make(chan error, 1)
+	go func() {
+		res <- svc.WatchEvents(&pb.WatchEventsRequest{Twitchnames: []string{"streamer"}}, stream)
+	}()
+	waitWatched(t, events, true)
+
+	// the client isn't receiving, so the first event blocks the stream and the
+	// rest fill the watcher's buffer until it overflows. the poller publishing
+	// the events must never wait on it
+	published := make(chan struct{})
+	go func() {
+		for i := 0; i < 100; i++ {
+			events.Publish(&twitch.Event{Type: twitch.EventUpdated, Login: "streamer"})
+		}
+		close(published)
+	}()
+
+	select {
+	case <-published:
+	case <-time.After(5 * time.Second):
+		t.Fatal("publishing blocked on a stream that isn't receiving")
+	}
+	if events.Watched() {
+		t.Fatal("expected the slow stream to be unsubscribed")
+	}
+
+	// the events buffered before it overflowed are still sent
+	close(stream.release)
+	select {
+	case err := <-res:
+		expectCode(t, err, codes.ResourceExhausted)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the stream to end once its buffered events were sent")
+	}
+}
+
+func TestExpiredToken(t *testing.T) {
+	client := newTestClient(t)
+	ctx := authorize(t, client, "bot")
+
+	_, err := client.GetChannels(ctx, &pb.GetChannelsRequest{Id: "1"})
+	expectCode(t, err, codes.NotFound)
+
+	skipTime(t, tokenExpiry+time.Minute)
+
+	_, err = client.GetChannels(ctx, &pb.GetChannelsRequest{Id: "1"})
+	expectCode(t, err, codes.Unauthenticated)
+
+	stream, err := client.WatchEvents(ctx, &pb.WatchEventsRequest{Twitchnames: []string{"streamer"}})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	expectCode(t, err, codes.Unauthenticated)
+}
+
+func TestRefreshToken(t *testing.T) {
+	client := newTestClient(t)
+	issued, err := client.IssueToken(context.Background(), &pb.IssueTokenRequest{Name: "bot", Secret: apiSecret})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.RefreshToken(context.Background(), &pb.RefreshTokenRequest{Token: issued.Token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+res.Token)
+	_, err = client.GetChannels(ctx, &pb.GetChannelsRequest{Id: "1"})
+	expectCode(t, err, codes.NotFound)
+
+	_, err = client.RefreshToken(context.Background(), &pb.RefreshTokenRequest{Token: "invalid"})
+	expectCode(t, err, codes.Unauthenticated)
+
+	skipTime(t, tokenExpiry+time.Minute)
+	_, err = client.RefreshToken(context.Background(), &pb.RefreshTokenRequest{Token: issued.Token})
+	expectCode(t, err, codes.Unauthenticated)
+}
+
+func TestIntrospectToken(t *testing.T) {
+	client := newTestClient(t)
+	issued, err := client.IssueToken(context.Background(), &pb.IssueTokenRequest{Name: "bot", Secret: apiSecret, Shard: 1, ShardCount: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.IntrospectToken(context.Background(), &pb.IntrospectTokenRequest{Token: issued.Token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Active || res.Name != "bot" || res.Shard != 1 || res.ShardCount != 2 || res.ExpiresAt != issued.ExpiresAt {
+		t.Fatalf("expected an active token for bot, got %v", res)
+	}
+
+	res, err = client.IntrospectToken(context.Background(), &pb.IntrospectTokenRequest{Token: "invalid"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Active || res.Name != "" {
+		t.Fatalf("expected an invalid token to be inactive without claims, got %v", res)
+	}
+
+	// an expired token is inactive, but its claims are still reported
+	skipTime(t, tokenExpiry+time.Minute)
+	res, err = client.IntrospectToken(context.Background(), &pb.IntrospectTokenRequest{Token: issued.Token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Active || res.Name != "bot" {
+		t.Fatalf("expected an inactive token for bot, got %v", res)
+	}
+}
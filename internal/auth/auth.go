@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// Claims are the custom jwt claims issued to each bot
+// the same tokens are accepted by both the rest and grpc apis
+type Claims struct {
+	Name       string `json:"name"`
+	Shard      int    `json:"shard"`
+	ShardCount int    `json:"shard_count,omitempty"`
+	// admins can see and manage every bot's subscriptions
+	Admin bool `json:"admin,omitempty"`
+	jwt.StandardClaims
+}
+
+// DefaultExpiry is how long tokens last if no expiry is configured
+const DefaultExpiry = 72 * time.Hour
+
+var (
+	// ErrExpired is returned when a token is valid but has expired
+	ErrExpired = errors.New("token is expired")
+	// ErrNoName is returned when issuing or accepting a token without a bot name
+	ErrNoName = errors.New("bot name is required")
+)
+
+// Issuer signs new tokens and refreshes existing ones
+type Issuer struct {
+	Secret []byte
+	Expiry time.Duration
+}
+
+// Issue signs a new token for a bot with the custom claims from c
+func (i *Issuer) Issue(c Claims) (string, *Claims, error) {
+	if c.Name == "" {
+		return "", nil, ErrNoName
+	}
+
+	expiry := i.Expiry
+	if expiry <= 0 {
+		expiry = DefaultExpiry
+	}
+
+	now := time.Now()
+	claims := &c
+	claims.StandardClaims = jwt.StandardClaims{
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(expiry).Unix(),
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(i.Secret)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, claims, nil
+}
+
+// Refresh exchanges a valid, unexpired token for a new one with the same claims
+func (i *Issuer) Refresh(token string) (string, *Claims, error) {
+	claims, err := Parse(token, i.Secret)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return i.Issue(*claims)
+}
+
+// Parse validates a HS256 signed token and returns its claims
+// if the token is only expired its claims are returned along with ErrExpired
+func Parse(token string, secret []byte) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if t.Method != jwt.SigningMethodHS256 {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		return secret, nil
+	})
+	if err != nil {
+		if verr, ok := err.(*jwt.ValidationError); ok && verr.Errors == jwt.ValidationErrorExpired {
+			return claims, ErrExpired
+		}
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the claims
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, contextKey{}, claims)
+}
+
+// FromContext returns the claims stored in ctx, if any
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(contextKey{}).(*Claims)
+	return claims, ok
+}
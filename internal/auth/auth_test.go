@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// skipTime makes tokens be validated as if d has passed
+func skipTime(t *testing.T, d time.Duration) {
+	jwt.TimeFunc = func() time.Time { return time.Now().Add(d) }
+	t.Cleanup(func() { jwt.TimeFunc = time.Now })
+}
+
+func newTestIssuer() *Issuer {
+	return &Issuer{Secret: []byte("secret"), Expiry: time.Minute}
+}
+
+func TestIssue(t *testing.T) {
+	i := newTestIssuer()
+
+	_, _, err := i.Issue(Claims{})
+	if err != ErrNoName {
+		t.Fatalf("expected %v, got %v", ErrNoName, err)
+	}
+
+	token, claims, err := i.Issue(Claims{Name: "bot", Shard: 1, ShardCount: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.ExpiresAt-claims.IssuedAt != int64(time.Minute/time.Second) {
+		t.Fatalf("expected the token to expire after a minute, issued at %d expires at %d", claims.IssuedAt, claims.ExpiresAt)
+	}
+
+	parsed, err := Parse(token, i.Secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Name != "bot" || parsed.Shard != 1 || parsed.ShardCount != 2 {
+		t.Fatalf("expected the issued claims back, got %+v", parsed)
+	}
+
+	_, err = Parse(token, []byte("other secret"))
+	if err == nil || err == ErrExpired {
+		t.Fatalf("expected a token signed with another secret to be invalid, got %v", err)
+	}
+}
+
+func TestExpired(t *testing.T) {
+	i := newTestIssuer()
+	token, _, err := i.Issue(Claims{Name: "bot", Admin: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	skipTime(t, 2*time.Minute)
+	// the claims are still returned so they can be introspected
+	claims, err := Parse(token, i.Secret)
+	if err != ErrExpired {
+		t.Fatalf("expected %v, got %v", ErrExpired, err)
+	}
+	if claims == nil || claims.Name != "bot" || !claims.Admin {
+		t.Fatalf("expected the expired token's claims, got %+v", claims)
+	}
+
+	_, _, err = i.Refresh(token)
+	if err != ErrExpired {
+		t.Fatalf("expected refreshing an expired token to fail with %v, got %v", ErrExpired, err)
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	i := newTestIssuer()
+	token, _, err := i.Issue(Claims{Name: "bot", Shard: 1, ShardCount: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// refresh just before the token expires
+	skipTime(t, 50*time.Second)
+	refreshed, claims, err := i.Refresh(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.Name != "bot" || claims.Shard != 1 || claims.ShardCount != 2 {
+		t.Fatalf("expected the refreshed token to keep its claims, got %+v", claims)
+	}
+	if _, err := Parse(refreshed, i.Secret); err != nil {
+		t.Fatalf("expected the refreshed token to be valid, got %v", err)
+	}
+
+	_, _, err = i.Refresh("invalid")
+	if err == nil {
+		t.Fatal("expected refreshing an invalid token to fail")
+	}
+}
@@ -15,7 +15,7 @@ const TableName = "games"
 
 // Row represents a row from 'games'.
 type Row struct {
-	ID        int       // id (PK)
+	ID        string    // id (PK)
 	BoxArtURL string    // box_art_url
 	CreatedAt time.Time // created_at
 	Name      string    // name
@@ -26,7 +26,7 @@ type Row struct {
 var (
 	BoxArtURLCol models.StringField   = "box_art_url"
 	CreatedAtCol models.TimeTimeField = "created_at"
-	IDCol        models.IntField      = "id"
+	IDCol        models.StringField   = "id"
 	NameCol      models.StringField   = "name"
 	UpdatedAtCol models.TimeTimeField = "updated_at"
 )
@@ -181,7 +181,7 @@ func First(ctx context.Context, db models.DB, where models.WhereClause, orderby
 
 // Find retrieves a row from 'games' by its primary key(s).
 func Find(ctx context.Context, db models.DB,
-	id int,
+	id string,
 ) (*Row, error) {
 	const sqlstr = `SELECT
 		box_art_url, created_at, id, name, updated_at
@@ -320,7 +320,7 @@ func Upsert(ctx context.Context, db models.DB, r *Row) error {
 // Delete deletes the Row from the database. Returns the number of items deleted.
 func Delete(ctx context.Context,
 	db models.DB,
-	id int,
+	id string,
 ) (int64, error) {
 	const sqlstr = `DELETE FROM public.games 
 	WHERE
@@ -29,6 +29,22 @@ CREATE EXTENSION IF NOT EXISTS plpgsql WITH SCHEMA pg_catalog;
 COMMENT ON EXTENSION plpgsql IS 'PL/pgSQL procedural language';
 
 
+--
+-- Name: set_updated_at(); Type: FUNCTION; Schema: public; Owner: colinadler
+--
+
+CREATE FUNCTION public.set_updated_at() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    NEW.updated_at := timezone('utc', now());
+    RETURN NEW;
+END;
+$$;
+
+
+ALTER FUNCTION public.set_updated_at() OWNER TO colinadler;
+
 SET default_tablespace = '';
 
 SET default_with_oids = false;
@@ -38,21 +54,91 @@ SET default_with_oids = false;
 --
 
 CREATE TABLE public.games (
-    id integer NOT NULL,
+    id text NOT NULL,
     name text NOT NULL,
     box_art_url text NOT NULL,
-    created_at timestamp without time zone NOT NULL,
-    updated_at timestamp without time zone NOT NULL
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL
 );
 
 
 ALTER TABLE public.games OWNER TO colinadler;
 
 --
--- Name: games_id_seq; Type: SEQUENCE; Schema: public; Owner: colinadler
+-- Name: live_messages; Type: TABLE; Schema: public; Owner: colinadler
+--
+
+CREATE TABLE public.live_messages (
+    twitch_login text NOT NULL,
+    channel text NOT NULL,
+    message_id text NOT NULL
+);
+
+
+ALTER TABLE public.live_messages OWNER TO colinadler;
+
+--
+-- Name: live_streams; Type: TABLE; Schema: public; Owner: colinadler
+--
+
+CREATE TABLE public.live_streams (
+    user_id text NOT NULL,
+    data jsonb NOT NULL
+);
+
+
+ALTER TABLE public.live_streams OWNER TO colinadler;
+
+--
+-- Name: meta; Type: TABLE; Schema: public; Owner: colinadler
+--
+
+CREATE TABLE public.meta (
+    key text NOT NULL,
+    value jsonb NOT NULL
+);
+
+
+ALTER TABLE public.meta OWNER TO colinadler;
+
+--
+-- Name: subscription_options; Type: TABLE; Schema: public; Owner: colinadler
+--
+
+CREATE TABLE public.subscription_options (
+    twitch_login text NOT NULL,
+    channel text NOT NULL,
+    options jsonb DEFAULT '{}'::jsonb NOT NULL
+);
+
+
+ALTER TABLE public.subscription_options OWNER TO colinadler;
+
+--
+-- Name: subscriptions; Type: TABLE; Schema: public; Owner: colinadler
+--
+
+CREATE TABLE public.subscriptions (
+    id integer NOT NULL,
+    guild text DEFAULT ''::text NOT NULL,
+    channel text NOT NULL,
+    webhook_id text NOT NULL,
+    twitch_login text NOT NULL,
+    twitch_user_id text,
+    owner text NOT NULL,
+    options jsonb DEFAULT '{}'::jsonb NOT NULL,
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL
+);
+
+
+ALTER TABLE public.subscriptions OWNER TO colinadler;
+
+--
+-- Name: subscriptions_id_seq; Type: SEQUENCE; Schema: public; Owner: colinadler
 --
 
-CREATE SEQUENCE public.games_id_seq
+CREATE SEQUENCE public.subscriptions_id_seq
     AS integer
     START WITH 1
     INCREMENT BY 1
@@ -61,13 +147,13 @@ CREATE SEQUENCE public.games_id_seq
     CACHE 1;
 
 
-ALTER TABLE public.games_id_seq OWNER TO colinadler;
+ALTER TABLE public.subscriptions_id_seq OWNER TO colinadler;
 
 --
--- Name: games_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: colinadler
+-- Name: subscriptions_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: colinadler
 --
 
-ALTER SEQUENCE public.games_id_seq OWNED BY public.games.id;
+ALTER SEQUENCE public.subscriptions_id_seq OWNED BY public.subscriptions.id;
 
 
 --
@@ -84,8 +170,8 @@ CREATE TABLE public.twitch_user (
     profile_image_url text NOT NULL,
     offline_image_url text NOT NULL,
     view_count integer NOT NULL,
-    created_at timestamp without time zone NOT NULL,
-    updated_at timestamp without time zone NOT NULL
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL
 );
 
 
@@ -120,8 +206,8 @@ ALTER SEQUENCE public.twitch_user_id_seq OWNED BY public.twitch_user.id;
 CREATE TABLE public.webhooks (
     id text NOT NULL,
     token text NOT NULL,
-    created_at timestamp without time zone NOT NULL,
-    updated_at timestamp without time zone NOT NULL
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL
 );
 
 
@@ -149,6 +235,13 @@ ALTER TABLE public.webhooks_id_seq OWNER TO colinadler;
 ALTER SEQUENCE public.webhooks_id_seq OWNED BY public.webhooks.id;
 
 
+--
+-- Name: subscriptions id; Type: DEFAULT; Schema: public; Owner: colinadler
+--
+
+ALTER TABLE ONLY public.subscriptions ALTER COLUMN id SET DEFAULT nextval('public.subscriptions_id_seq'::regclass);
+
+
 --
 -- Data for Name: games; Type: TABLE DATA; Schema: public; Owner: colinadler
 --
@@ -157,6 +250,46 @@ COPY public.games (id, name, box_art_url, created_at, updated_at) FROM stdin;
 \.
 
 
+--
+-- Data for Name: live_messages; Type: TABLE DATA; Schema: public; Owner: colinadler
+--
+
+COPY public.live_messages (twitch_login, channel, message_id) FROM stdin;
+\.
+
+
+--
+-- Data for Name: live_streams; Type: TABLE DATA; Schema: public; Owner: colinadler
+--
+
+COPY public.live_streams (user_id, data) FROM stdin;
+\.
+
+
+--
+-- Data for Name: meta; Type: TABLE DATA; Schema: public; Owner: colinadler
+--
+
+COPY public.meta (key, value) FROM stdin;
+\.
+
+
+--
+-- Data for Name: subscription_options; Type: TABLE DATA; Schema: public; Owner: colinadler
+--
+
+COPY public.subscription_options (twitch_login, channel, options) FROM stdin;
+\.
+
+
+--
+-- Data for Name: subscriptions; Type: TABLE DATA; Schema: public; Owner: colinadler
+--
+
+COPY public.subscriptions (id, guild, channel, webhook_id, twitch_login, twitch_user_id, owner, options, created_at, updated_at) FROM stdin;
+\.
+
+
 --
 -- Data for Name: twitch_user; Type: TABLE DATA; Schema: public; Owner: colinadler
 --
@@ -174,10 +307,10 @@ COPY public.webhooks (id, token, created_at, updated_at) FROM stdin;
 
 
 --
--- Name: games_id_seq; Type: SEQUENCE SET; Schema: public; Owner: colinadler
+-- Name: subscriptions_id_seq; Type: SEQUENCE SET; Schema: public; Owner: colinadler
 --
 
-SELECT pg_catalog.setval('public.games_id_seq', 1, false);
+SELECT pg_catalog.setval('public.subscriptions_id_seq', 1, false);
 
 
 --
@@ -202,6 +335,54 @@ ALTER TABLE ONLY public.games
     ADD CONSTRAINT games_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: live_messages live_messages_pkey; Type: CONSTRAINT; Schema: public; Owner: colinadler
+--
+
+ALTER TABLE ONLY public.live_messages
+    ADD CONSTRAINT live_messages_pkey PRIMARY KEY (twitch_login, channel);
+
+
+--
+-- Name: live_streams live_streams_pkey; Type: CONSTRAINT; Schema: public; Owner: colinadler
+--
+
+ALTER TABLE ONLY public.live_streams
+    ADD CONSTRAINT live_streams_pkey PRIMARY KEY (user_id);
+
+
+--
+-- Name: meta meta_pkey; Type: CONSTRAINT; Schema: public; Owner: colinadler
+--
+
+ALTER TABLE ONLY public.meta
+    ADD CONSTRAINT meta_pkey PRIMARY KEY (key);
+
+
+--
+-- Name: subscription_options subscription_options_pkey; Type: CONSTRAINT; Schema: public; Owner: colinadler
+--
+
+ALTER TABLE ONLY public.subscription_options
+    ADD CONSTRAINT subscription_options_pkey PRIMARY KEY (twitch_login, channel);
+
+
+--
+-- Name: subscriptions subscriptions_pkey; Type: CONSTRAINT; Schema: public; Owner: colinadler
+--
+
+ALTER TABLE ONLY public.subscriptions
+    ADD CONSTRAINT subscriptions_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: subscriptions subscriptions_twitch_login_channel_key; Type: CONSTRAINT; Schema: public; Owner: colinadler
+--
+
+ALTER TABLE ONLY public.subscriptions
+    ADD CONSTRAINT subscriptions_twitch_login_channel_key UNIQUE (twitch_login, channel);
+
+
 --
 -- Name: twitch_user twitch_user_pkey; Type: CONSTRAINT; Schema: public; Owner: colinadler
 --
@@ -218,6 +399,101 @@ ALTER TABLE ONLY public.webhooks
     ADD CONSTRAINT webhooks_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: subscriptions_channel_idx; Type: INDEX; Schema: public; Owner: colinadler
+--
+
+CREATE INDEX subscriptions_channel_idx ON public.subscriptions USING btree (channel);
+
+
+--
+-- Name: subscriptions_guild_idx; Type: INDEX; Schema: public; Owner: colinadler
+--
+
+CREATE INDEX subscriptions_guild_idx ON public.subscriptions USING btree (guild);
+
+
+--
+-- Name: subscriptions_twitch_user_id_idx; Type: INDEX; Schema: public; Owner: colinadler
+--
+
+CREATE INDEX subscriptions_twitch_user_id_idx ON public.subscriptions USING btree (twitch_user_id);
+
+
+--
+-- Name: subscriptions_webhook_id_idx; Type: INDEX; Schema: public; Owner: colinadler
+--
+
+CREATE INDEX subscriptions_webhook_id_idx ON public.subscriptions USING btree (webhook_id);
+
+
+--
+-- Name: twitch_user_login_idx; Type: INDEX; Schema: public; Owner: colinadler
+--
+
+CREATE INDEX twitch_user_login_idx ON public.twitch_user USING btree (login);
+
+
+--
+-- Name: games games_set_updated_at; Type: TRIGGER; Schema: public; Owner: colinadler
+--
+
+CREATE TRIGGER games_set_updated_at BEFORE UPDATE ON public.games FOR EACH ROW EXECUTE PROCEDURE public.set_updated_at();
+
+
+--
+-- Name: subscriptions subscriptions_set_updated_at; Type: TRIGGER; Schema: public; Owner: colinadler
+--
+
+CREATE TRIGGER subscriptions_set_updated_at BEFORE UPDATE ON public.subscriptions FOR EACH ROW EXECUTE PROCEDURE public.set_updated_at();
+
+
+--
+-- Name: twitch_user twitch_user_set_updated_at; Type: TRIGGER; Schema: public; Owner: colinadler
+--
+
+CREATE TRIGGER twitch_user_set_updated_at BEFORE UPDATE ON public.twitch_user FOR EACH ROW EXECUTE PROCEDURE public.set_updated_at();
+
+
+--
+-- Name: webhooks webhooks_set_updated_at; Type: TRIGGER; Schema: public; Owner: colinadler
+--
+
+CREATE TRIGGER webhooks_set_updated_at BEFORE UPDATE ON public.webhooks FOR EACH ROW EXECUTE PROCEDURE public.set_updated_at();
+
+
+--
+-- Name: live_messages live_messages_subscription_fkey; Type: FK CONSTRAINT; Schema: public; Owner: colinadler
+--
+
+ALTER TABLE ONLY public.live_messages
+    ADD CONSTRAINT live_messages_subscription_fkey FOREIGN KEY (twitch_login, channel) REFERENCES public.subscriptions(twitch_login, channel) ON DELETE CASCADE;
+
+
+--
+-- Name: subscription_options subscription_options_subscription_fkey; Type: FK CONSTRAINT; Schema: public; Owner: colinadler
+--
+
+ALTER TABLE ONLY public.subscription_options
+    ADD CONSTRAINT subscription_options_subscription_fkey FOREIGN KEY (twitch_login, channel) REFERENCES public.subscriptions(twitch_login, channel) ON DELETE CASCADE;
+
+
+--
+-- Name: subscriptions subscriptions_twitch_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: colinadler
+--
+
+ALTER TABLE ONLY public.subscriptions
+    ADD CONSTRAINT subscriptions_twitch_user_id_fkey FOREIGN KEY (twitch_user_id) REFERENCES public.twitch_user(id) ON DELETE SET NULL;
+
+
+--
+-- Name: subscriptions subscriptions_webhook_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: colinadler
+--
+
+ALTER TABLE ONLY public.subscriptions
+    ADD CONSTRAINT subscriptions_webhook_id_fkey FOREIGN KEY (webhook_id) REFERENCES public.webhooks(id) ON DELETE CASCADE;
+
+
 --
 -- PostgreSQL database dump complete
 --
@@ -0,0 +1,39 @@
+-- upgrades a database created from an older dump.sql so the twitch_user
+-- and games tables can be used as the user and game cache
+
+BEGIN;
+
+-- helix game ids are strings
+ALTER TABLE public.games ALTER COLUMN id DROP DEFAULT;
+ALTER TABLE public.games ALTER COLUMN id TYPE text USING id::text;
+DROP SEQUENCE IF EXISTS public.games_id_seq;
+
+-- the generated Insert and Upsert don't set the timestamps
+ALTER TABLE public.games ALTER COLUMN created_at SET DEFAULT timezone('utc', now());
+ALTER TABLE public.games ALTER COLUMN updated_at SET DEFAULT timezone('utc', now());
+ALTER TABLE public.twitch_user ALTER COLUMN created_at SET DEFAULT timezone('utc', now());
+ALTER TABLE public.twitch_user ALTER COLUMN updated_at SET DEFAULT timezone('utc', now());
+ALTER TABLE public.webhooks ALTER COLUMN created_at SET DEFAULT timezone('utc', now());
+ALTER TABLE public.webhooks ALTER COLUMN updated_at SET DEFAULT timezone('utc', now());
+
+-- cached rows expire by updated_at, so every update has to bump it
+CREATE OR REPLACE FUNCTION public.set_updated_at() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    NEW.updated_at := timezone('utc', now());
+    RETURN NEW;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS games_set_updated_at ON public.games;
+CREATE TRIGGER games_set_updated_at BEFORE UPDATE ON public.games FOR EACH ROW EXECUTE PROCEDURE public.set_updated_at();
+DROP TRIGGER IF EXISTS twitch_user_set_updated_at ON public.twitch_user;
+CREATE TRIGGER twitch_user_set_updated_at BEFORE UPDATE ON public.twitch_user FOR EACH ROW EXECUTE PROCEDURE public.set_updated_at();
+DROP TRIGGER IF EXISTS webhooks_set_updated_at ON public.webhooks;
+CREATE TRIGGER webhooks_set_updated_at BEFORE UPDATE ON public.webhooks FOR EACH ROW EXECUTE PROCEDURE public.set_updated_at();
+
+-- users are invalidated by login
+CREATE INDEX IF NOT EXISTS twitch_user_login_idx ON public.twitch_user USING btree (login);
+
+COMMIT;
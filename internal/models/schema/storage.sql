@@ -0,0 +1,28 @@
+-- upgrades a database created from an older dump.sql so it can be used as storage
+-- run metadata_cache.sql first
+
+BEGIN;
+
+-- streams that were live as of the last poll, by twitch user id
+CREATE TABLE IF NOT EXISTS public.live_streams (
+    user_id text NOT NULL,
+    data jsonb NOT NULL,
+    CONSTRAINT live_streams_pkey PRIMARY KEY (user_id)
+);
+
+-- the go live message sent to each discord channel for a stream
+CREATE TABLE IF NOT EXISTS public.live_messages (
+    twitch_login text NOT NULL,
+    channel text NOT NULL,
+    message_id text NOT NULL,
+    CONSTRAINT live_messages_pkey PRIMARY KEY (twitch_login, channel)
+);
+
+-- things like the app access token
+CREATE TABLE IF NOT EXISTS public.meta (
+    key text NOT NULL,
+    value jsonb NOT NULL,
+    CONSTRAINT meta_pkey PRIMARY KEY (key)
+);
+
+COMMIT;
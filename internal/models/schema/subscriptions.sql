@@ -0,0 +1,61 @@
+-- upgrades a database created from an older dump.sql so it can store subscriptions
+-- run metadata_cache.sql and storage.sql first
+
+BEGIN;
+
+-- a discord channel receiving updates for a twitch channel
+CREATE TABLE IF NOT EXISTS public.subscriptions (
+    id serial NOT NULL,
+    -- empty if the guild isn't known
+    guild text DEFAULT ''::text NOT NULL,
+    channel text NOT NULL,
+    webhook_id text NOT NULL,
+    twitch_login text NOT NULL,
+    -- set once the twitch user is cached
+    twitch_user_id text,
+    -- the bot that owns the subscription
+    owner text NOT NULL,
+    -- shared by every subscription in a channel
+    options jsonb DEFAULT '{}'::jsonb NOT NULL,
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    CONSTRAINT subscriptions_pkey PRIMARY KEY (id),
+    CONSTRAINT subscriptions_twitch_login_channel_key UNIQUE (twitch_login, channel),
+    CONSTRAINT subscriptions_webhook_id_fkey FOREIGN KEY (webhook_id) REFERENCES public.webhooks(id) ON DELETE CASCADE,
+    CONSTRAINT subscriptions_twitch_user_id_fkey FOREIGN KEY (twitch_user_id) REFERENCES public.twitch_user(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS subscriptions_channel_idx ON public.subscriptions USING btree (channel);
+CREATE INDEX IF NOT EXISTS subscriptions_guild_idx ON public.subscriptions USING btree (guild);
+CREATE INDEX IF NOT EXISTS subscriptions_twitch_user_id_idx ON public.subscriptions USING btree (twitch_user_id);
+CREATE INDEX IF NOT EXISTS subscriptions_webhook_id_idx ON public.subscriptions USING btree (webhook_id);
+
+DROP TRIGGER IF EXISTS subscriptions_set_updated_at ON public.subscriptions;
+CREATE TRIGGER subscriptions_set_updated_at BEFORE UPDATE ON public.subscriptions FOR EACH ROW EXECUTE PROCEDURE public.set_updated_at();
+
+-- go live messages are deleted along with their subscription
+DELETE FROM public.live_messages m WHERE NOT EXISTS (
+    SELECT 1 FROM public.subscriptions s WHERE s.twitch_login = m.twitch_login AND s.channel = m.channel
+);
+ALTER TABLE public.live_messages DROP CONSTRAINT IF EXISTS live_messages_subscription_fkey;
+ALTER TABLE public.live_messages ADD CONSTRAINT live_messages_subscription_fkey
+    FOREIGN KEY (twitch_login, channel) REFERENCES public.subscriptions(twitch_login, channel) ON DELETE CASCADE;
+
+-- the notification settings of each subscription, deleted along with it
+CREATE TABLE IF NOT EXISTS public.subscription_options (
+    twitch_login text NOT NULL,
+    channel text NOT NULL,
+    options jsonb DEFAULT '{}'::jsonb NOT NULL,
+    CONSTRAINT subscription_options_pkey PRIMARY KEY (twitch_login, channel),
+    CONSTRAINT subscription_options_subscription_fkey FOREIGN KEY (twitch_login, channel)
+        REFERENCES public.subscriptions(twitch_login, channel) ON DELETE CASCADE
+);
+
+-- stream updates used to be enabled for every subscription in a channel
+INSERT INTO public.subscription_options (twitch_login, channel, options)
+    SELECT twitch_login, channel, '{"stream_updates": true}'::jsonb FROM public.subscriptions
+    WHERE options->>'stream_updates' = 'true'
+    ON CONFLICT (twitch_login, channel) DO NOTHING;
+UPDATE public.subscriptions SET options = options - 'stream_updates' WHERE options ? 'stream_updates';
+
+COMMIT;
@@ -3,14 +3,17 @@
 
 package pb
 
-import proto "github.com/gogo/protobuf/proto"
-import fmt "fmt"
-import math "math"
-
-import context "golang.org/x/net/context"
-import grpc "google.golang.org/grpc"
-
-import io "io"
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -33,7 +36,7 @@ func (m *GetChannelsRequest) Reset()         { *m = GetChannelsRequest{} }
 func (m *GetChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetChannelsRequest) ProtoMessage()    {}
 func (*GetChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_twitch_e613e3fb58de86e8, []int{0}
+	return fileDescriptor_a5baf264659b5c94, []int{0}
 }
 func (m *GetChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -43,15 +46,15 @@ func (m *GetChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_GetChannelsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *GetChannelsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetChannelsRequest.Merge(dst, src)
+func (m *GetChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelsRequest.Merge(m, src)
 }
 func (m *GetChannelsRequest) XXX_Size() int {
 	return m.Size()
@@ -70,7 +73,9 @@ func (m *GetChannelsRequest) GetId() string {
 }
 
 type GetChannelsResponse struct {
-	Name                 []string `protobuf:"bytes,1,rep,name=name" json:"name,omitempty"`
+	Name []string `protobuf:"bytes,1,rep,name=name,proto3" json:"name,omitempty"`
+	// current webhook used for the discord channel
+	Webhook              *Webhook `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -79,7 +84,7 @@ func (m *GetChannelsResponse) Reset()         { *m = GetChannelsResponse{} }
 func (m *GetChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetChannelsResponse) ProtoMessage()    {}
 func (*GetChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_twitch_e613e3fb58de86e8, []int{1}
+	return fileDescriptor_a5baf264659b5c94, []int{1}
 }
 func (m *GetChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -89,15 +94,15 @@ func (m *GetChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_GetChannelsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *GetChannelsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetChannelsResponse.Merge(dst, src)
+func (m *GetChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelsResponse.Merge(m, src)
 }
 func (m *GetChannelsResponse) XXX_Size() int {
 	return m.Size()
@@ -115,11 +120,78 @@ func (m *GetChannelsResponse) GetName() []string {
 	return nil
 }
 
+func (m *GetChannelsResponse) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+type Webhook struct {
+	// discord webhook id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// discord webhook token
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Webhook) Reset()         { *m = Webhook{} }
+func (m *Webhook) String() string { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()    {}
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{2}
+}
+func (m *Webhook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Webhook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhook.Merge(m, src)
+}
+func (m *Webhook) XXX_Size() int {
+	return m.Size()
+}
+func (m *Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhook proto.InternalMessageInfo
+
+func (m *Webhook) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Webhook) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 type NewWebhookRequest struct {
 	// discord channel id
 	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
 	// twitch username
-	Twitchname           string   `protobuf:"bytes,2,opt,name=twitchname,proto3" json:"twitchname,omitempty"`
+	Twitchname string `protobuf:"bytes,2,opt,name=twitchname,proto3" json:"twitchname,omitempty"`
+	// webhook to send updates to
+	Webhook *Webhook `protobuf:"bytes,3,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// discord guild id the channel is in
+	Guild                string   `protobuf:"bytes,4,opt,name=guild,proto3" json:"guild,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -128,7 +200,7 @@ func (m *NewWebhookRequest) Reset()         { *m = NewWebhookRequest{} }
 func (m *NewWebhookRequest) String() string { return proto.CompactTextString(m) }
 func (*NewWebhookRequest) ProtoMessage()    {}
 func (*NewWebhookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_twitch_e613e3fb58de86e8, []int{2}
+	return fileDescriptor_a5baf264659b5c94, []int{3}
 }
 func (m *NewWebhookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,15 +210,15 @@ func (m *NewWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_NewWebhookRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *NewWebhookRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NewWebhookRequest.Merge(dst, src)
+func (m *NewWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewWebhookRequest.Merge(m, src)
 }
 func (m *NewWebhookRequest) XXX_Size() int {
 	return m.Size()
@@ -171,6 +243,20 @@ func (m *NewWebhookRequest) GetTwitchname() string {
 	return ""
 }
 
+func (m *NewWebhookRequest) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+func (m *NewWebhookRequest) GetGuild() string {
+	if m != nil {
+		return m.Guild
+	}
+	return ""
+}
+
 type NewWebhookResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -180,7 +266,7 @@ func (m *NewWebhookResponse) Reset()         { *m = NewWebhookResponse{} }
 func (m *NewWebhookResponse) String() string { return proto.CompactTextString(m) }
 func (*NewWebhookResponse) ProtoMessage()    {}
 func (*NewWebhookResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_twitch_e613e3fb58de86e8, []int{3}
+	return fileDescriptor_a5baf264659b5c94, []int{4}
 }
 func (m *NewWebhookResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,15 +276,15 @@ func (m *NewWebhookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_NewWebhookResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *NewWebhookResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NewWebhookResponse.Merge(dst, src)
+func (m *NewWebhookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewWebhookResponse.Merge(m, src)
 }
 func (m *NewWebhookResponse) XXX_Size() int {
 	return m.Size()
@@ -222,7 +308,7 @@ func (m *DeleteWebhookRequest) Reset()         { *m = DeleteWebhookRequest{} }
 func (m *DeleteWebhookRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteWebhookRequest) ProtoMessage()    {}
 func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_twitch_e613e3fb58de86e8, []int{4}
+	return fileDescriptor_a5baf264659b5c94, []int{5}
 }
 func (m *DeleteWebhookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,15 +318,15 @@ func (m *DeleteWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_DeleteWebhookRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *DeleteWebhookRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteWebhookRequest.Merge(dst, src)
+func (m *DeleteWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWebhookRequest.Merge(m, src)
 }
 func (m *DeleteWebhookRequest) XXX_Size() int {
 	return m.Size()
@@ -274,7 +360,7 @@ func (m *DeleteWebhookResponse) Reset()         { *m = DeleteWebhookResponse{} }
 func (m *DeleteWebhookResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteWebhookResponse) ProtoMessage()    {}
 func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_twitch_e613e3fb58de86e8, []int{5}
+	return fileDescriptor_a5baf264659b5c94, []int{6}
 }
 func (m *DeleteWebhookResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,15 +370,15 @@ func (m *DeleteWebhookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_DeleteWebhookResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *DeleteWebhookResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteWebhookResponse.Merge(dst, src)
+func (m *DeleteWebhookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWebhookResponse.Merge(m, src)
 }
 func (m *DeleteWebhookResponse) XXX_Size() int {
 	return m.Size()
@@ -303,391 +389,6345 @@ func (m *DeleteWebhookResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteWebhookResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*GetChannelsRequest)(nil), "twitch.GetChannelsRequest")
-	proto.RegisterType((*GetChannelsResponse)(nil), "twitch.GetChannelsResponse")
-	proto.RegisterType((*NewWebhookRequest)(nil), "twitch.NewWebhookRequest")
-	proto.RegisterType((*NewWebhookResponse)(nil), "twitch.NewWebhookResponse")
-	proto.RegisterType((*DeleteWebhookRequest)(nil), "twitch.DeleteWebhookRequest")
-	proto.RegisterType((*DeleteWebhookResponse)(nil), "twitch.DeleteWebhookResponse")
+type WatchEventsRequest struct {
+	// discord channel ids to receive events for
+	Channels []string `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// twitch usernames to receive events for
+	Twitchnames          []string `protobuf:"bytes,2,rep,name=twitchnames,proto3" json:"twitchnames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *WatchEventsRequest) Reset()         { *m = WatchEventsRequest{} }
+func (m *WatchEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchEventsRequest) ProtoMessage()    {}
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{7}
+}
+func (m *WatchEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEventsRequest.Merge(m, src)
+}
+func (m *WatchEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEventsRequest.DiscardUnknown(m)
+}
 
-// Client API for Twitch service
+var xxx_messageInfo_WatchEventsRequest proto.InternalMessageInfo
 
-type TwitchClient interface {
-	GetChannels(ctx context.Context, in *GetChannelsRequest, opts ...grpc.CallOption) (*GetChannelsResponse, error)
-	NewWebhook(ctx context.Context, in *NewWebhookRequest, opts ...grpc.CallOption) (*NewWebhookResponse, error)
-	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+func (m *WatchEventsRequest) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
 }
 
-type twitchClient struct {
-	cc *grpc.ClientConn
+func (m *WatchEventsRequest) GetTwitchnames() []string {
+	if m != nil {
+		return m.Twitchnames
+	}
+	return nil
 }
 
-func NewTwitchClient(cc *grpc.ClientConn) TwitchClient {
-	return &twitchClient{cc}
+type Stream struct {
+	// twitch stream id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// twitch user id
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// twitch username
+	Twitchname string `protobuf:"bytes,3,opt,name=twitchname,proto3" json:"twitchname,omitempty"`
+	GameId     string `protobuf:"bytes,4,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Title      string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Viewers    int32  `protobuf:"varint,6,opt,name=viewers,proto3" json:"viewers,omitempty"`
+	// unix timestamp in seconds
+	StartedAt            int64    `protobuf:"varint,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	ThumbnailUrl         string   `protobuf:"bytes,8,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *twitchClient) GetChannels(ctx context.Context, in *GetChannelsRequest, opts ...grpc.CallOption) (*GetChannelsResponse, error) {
-	out := new(GetChannelsResponse)
-	err := c.cc.Invoke(ctx, "/twitch.Twitch/GetChannels", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Stream) Reset()         { *m = Stream{} }
+func (m *Stream) String() string { return proto.CompactTextString(m) }
+func (*Stream) ProtoMessage()    {}
+func (*Stream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{8}
+}
+func (m *Stream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *Stream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stream.Merge(m, src)
+}
+func (m *Stream) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stream) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stream.DiscardUnknown(m)
 }
 
-func (c *twitchClient) NewWebhook(ctx context.Context, in *NewWebhookRequest, opts ...grpc.CallOption) (*NewWebhookResponse, error) {
-	out := new(NewWebhookResponse)
-	err := c.cc.Invoke(ctx, "/twitch.Twitch/NewWebhook", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Stream proto.InternalMessageInfo
+
+func (m *Stream) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *twitchClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
-	out := new(DeleteWebhookResponse)
-	err := c.cc.Invoke(ctx, "/twitch.Twitch/DeleteWebhook", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Stream) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	return out, nil
+	return ""
 }
 
-// Server API for Twitch service
-
-type TwitchServer interface {
-	GetChannels(context.Context, *GetChannelsRequest) (*GetChannelsResponse, error)
-	NewWebhook(context.Context, *NewWebhookRequest) (*NewWebhookResponse, error)
-	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+func (m *Stream) GetTwitchname() string {
+	if m != nil {
+		return m.Twitchname
+	}
+	return ""
 }
 
-func RegisterTwitchServer(s *grpc.Server, srv TwitchServer) {
-	s.RegisterService(&_Twitch_serviceDesc, srv)
+func (m *Stream) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
 }
 
-func _Twitch_GetChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetChannelsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TwitchServer).GetChannels(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/twitch.Twitch/GetChannels",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitchServer).GetChannels(ctx, req.(*GetChannelsRequest))
+func (m *Stream) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Twitch_NewWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewWebhookRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TwitchServer).NewWebhook(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/twitch.Twitch/NewWebhook",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitchServer).NewWebhook(ctx, req.(*NewWebhookRequest))
+func (m *Stream) GetViewers() int32 {
+	if m != nil {
+		return m.Viewers
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Twitch_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteWebhookRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TwitchServer).DeleteWebhook(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/twitch.Twitch/DeleteWebhook",
+func (m *Stream) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitchServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	return 0
+}
+
+func (m *Stream) GetThumbnailUrl() string {
+	if m != nil {
+		return m.ThumbnailUrl
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Twitch_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "twitch.Twitch",
-	HandlerType: (*TwitchServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetChannels",
-			Handler:    _Twitch_GetChannels_Handler,
-		},
-		{
-			MethodName: "NewWebhook",
-			Handler:    _Twitch_NewWebhook_Handler,
-		},
-		{
-			MethodName: "DeleteWebhook",
-			Handler:    _Twitch_DeleteWebhook_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "twitch.proto",
+type StreamOnline struct {
+	Stream               *Stream  `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetChannelsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StreamOnline) Reset()         { *m = StreamOnline{} }
+func (m *StreamOnline) String() string { return proto.CompactTextString(m) }
+func (*StreamOnline) ProtoMessage()    {}
+func (*StreamOnline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{9}
+}
+func (m *StreamOnline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOnline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOnline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *StreamOnline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOnline.Merge(m, src)
+}
+func (m *StreamOnline) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamOnline) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOnline.DiscardUnknown(m)
 }
 
-func (m *GetChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+var xxx_messageInfo_StreamOnline proto.InternalMessageInfo
+
+func (m *StreamOnline) GetStream() *Stream {
+	if m != nil {
+		return m.Stream
 	}
-	return i, nil
+	return nil
 }
 
-func (m *GetChannelsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type StreamOffline struct {
+	// last known state of the stream
+	Stream               *Stream  `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		for _, s := range m.Name {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+func (m *StreamOffline) Reset()         { *m = StreamOffline{} }
+func (m *StreamOffline) String() string { return proto.CompactTextString(m) }
+func (*StreamOffline) ProtoMessage()    {}
+func (*StreamOffline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{10}
+}
+func (m *StreamOffline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOffline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOffline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *StreamOffline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOffline.Merge(m, src)
+}
+func (m *StreamOffline) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamOffline) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOffline.DiscardUnknown(m)
 }
 
-func (m *NewWebhookRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StreamOffline proto.InternalMessageInfo
+
+func (m *StreamOffline) GetStream() *Stream {
+	if m != nil {
+		return m.Stream
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *NewWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Channel) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Channel)))
-		i += copy(dAtA[i:], m.Channel)
+type StreamUpdated struct {
+	Stream               *Stream  `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Previous             *Stream  `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamUpdated) Reset()         { *m = StreamUpdated{} }
+func (m *StreamUpdated) String() string { return proto.CompactTextString(m) }
+func (*StreamUpdated) ProtoMessage()    {}
+func (*StreamUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{11}
+}
+func (m *StreamUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Twitchname) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Twitchname)))
-		i += copy(dAtA[i:], m.Twitchname)
+}
+func (m *StreamUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamUpdated.Merge(m, src)
+}
+func (m *StreamUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamUpdated proto.InternalMessageInfo
+
+func (m *StreamUpdated) GetStream() *Stream {
+	if m != nil {
+		return m.Stream
 	}
-	return i, nil
+	return nil
 }
 
-func (m *NewWebhookResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StreamUpdated) GetPrevious() *Stream {
+	if m != nil {
+		return m.Previous
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *NewWebhookResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+type Event struct {
+	// discord channels tracking the stream
+	Channels []string `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//	*Event_Online
+	//	*Event_Offline
+	//	*Event_Updated
+	Event                isEvent_Event `protobuf_oneof:"event"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *DeleteWebhookRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{12}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
 }
 
-func (m *DeleteWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Channel) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Channel)))
-		i += copy(dAtA[i:], m.Channel)
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+type isEvent_Event interface {
+	isEvent_Event()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Event_Online struct {
+	Online *StreamOnline `protobuf:"bytes,2,opt,name=online,proto3,oneof" json:"online,omitempty"`
+}
+type Event_Offline struct {
+	Offline *StreamOffline `protobuf:"bytes,3,opt,name=offline,proto3,oneof" json:"offline,omitempty"`
+}
+type Event_Updated struct {
+	Updated *StreamUpdated `protobuf:"bytes,4,opt,name=updated,proto3,oneof" json:"updated,omitempty"`
+}
+
+func (*Event_Online) isEvent_Event()  {}
+func (*Event_Offline) isEvent_Event() {}
+func (*Event_Updated) isEvent_Event() {}
+
+func (m *Event) GetEvent() isEvent_Event {
+	if m != nil {
+		return m.Event
 	}
-	if len(m.Twitchname) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Twitchname)))
-		i += copy(dAtA[i:], m.Twitchname)
+	return nil
+}
+
+func (m *Event) GetChannels() []string {
+	if m != nil {
+		return m.Channels
 	}
-	return i, nil
+	return nil
 }
 
-func (m *DeleteWebhookResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Event) GetOnline() *StreamOnline {
+	if x, ok := m.GetEvent().(*Event_Online); ok {
+		return x.Online
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DeleteWebhookResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+func (m *Event) GetOffline() *StreamOffline {
+	if x, ok := m.GetEvent().(*Event_Offline); ok {
+		return x.Offline
+	}
+	return nil
 }
 
-func encodeVarintTwitch(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Event) GetUpdated() *StreamUpdated {
+	if x, ok := m.GetEvent().(*Event_Updated); ok {
+		return x.Updated
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return nil
 }
-func (m *GetChannelsRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTwitch(uint64(l))
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Event) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Event_Online)(nil),
+		(*Event_Offline)(nil),
+		(*Event_Updated)(nil),
 	}
-	return n
 }
 
-func (m *GetChannelsResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		for _, s := range m.Name {
-			l = len(s)
-			n += 1 + l + sovTwitch(uint64(l))
+type IssueTokenRequest struct {
+	// bot name
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Shard int32  `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	// api secret, or the admin secret for an admin token
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// total amount of shards the bot runs
+	ShardCount           int32    `protobuf:"varint,4,opt,name=shard_count,json=shardCount,proto3" json:"shard_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IssueTokenRequest) Reset()         { *m = IssueTokenRequest{} }
+func (m *IssueTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*IssueTokenRequest) ProtoMessage()    {}
+func (*IssueTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{13}
+}
+func (m *IssueTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssueTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssueTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *IssueTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueTokenRequest.Merge(m, src)
+}
+func (m *IssueTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssueTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueTokenRequest.DiscardUnknown(m)
 }
 
-func (m *NewWebhookRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTwitch(uint64(l))
-	}
-	l = len(m.Twitchname)
-	if l > 0 {
-		n += 1 + l + sovTwitch(uint64(l))
+var xxx_messageInfo_IssueTokenRequest proto.InternalMessageInfo
+
+func (m *IssueTokenRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return n
+	return ""
 }
 
-func (m *NewWebhookResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *IssueTokenRequest) GetShard() int32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
 }
 
-func (m *DeleteWebhookRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTwitch(uint64(l))
+func (m *IssueTokenRequest) GetSecret() string {
+	if m != nil {
+		return m.Secret
 	}
-	l = len(m.Twitchname)
-	if l > 0 {
-		n += 1 + l + sovTwitch(uint64(l))
+	return ""
+}
+
+func (m *IssueTokenRequest) GetShardCount() int32 {
+	if m != nil {
+		return m.ShardCount
 	}
-	return n
+	return 0
 }
 
-func (m *DeleteWebhookResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
+type RefreshTokenRequest struct {
+	// unexpired token to refresh
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func sovTwitch(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *RefreshTokenRequest) Reset()         { *m = RefreshTokenRequest{} }
+func (m *RefreshTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenRequest) ProtoMessage()    {}
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{14}
+}
+func (m *RefreshTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
+	}
+}
+func (m *RefreshTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenRequest.Merge(m, src)
+}
+func (m *RefreshTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshTokenRequest proto.InternalMessageInfo
+
+func (m *RefreshTokenRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type TokenResponse struct {
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// unix timestamp in seconds
+	ExpiresAt            int64    `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenResponse) Reset()         { *m = TokenResponse{} }
+func (m *TokenResponse) String() string { return proto.CompactTextString(m) }
+func (*TokenResponse) ProtoMessage()    {}
+func (*TokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{15}
+}
+func (m *TokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenResponse.Merge(m, src)
+}
+func (m *TokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenResponse proto.InternalMessageInfo
+
+func (m *TokenResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *TokenResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type IntrospectTokenRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IntrospectTokenRequest) Reset()         { *m = IntrospectTokenRequest{} }
+func (m *IntrospectTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*IntrospectTokenRequest) ProtoMessage()    {}
+func (*IntrospectTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{16}
+}
+func (m *IntrospectTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntrospectTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntrospectTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntrospectTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntrospectTokenRequest.Merge(m, src)
+}
+func (m *IntrospectTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntrospectTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntrospectTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntrospectTokenRequest proto.InternalMessageInfo
+
+func (m *IntrospectTokenRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type IntrospectTokenResponse struct {
+	// whether the token is valid and unexpired
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// bot name
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Shard int32  `protobuf:"varint,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	// unix timestamps in seconds
+	IssuedAt  int64 `protobuf:"varint,4,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// whether the token can see every bot's subscriptions
+	Admin                bool     `protobuf:"varint,6,opt,name=admin,proto3" json:"admin,omitempty"`
+	ShardCount           int32    `protobuf:"varint,7,opt,name=shard_count,json=shardCount,proto3" json:"shard_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IntrospectTokenResponse) Reset()         { *m = IntrospectTokenResponse{} }
+func (m *IntrospectTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*IntrospectTokenResponse) ProtoMessage()    {}
+func (*IntrospectTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{17}
+}
+func (m *IntrospectTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntrospectTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntrospectTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntrospectTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntrospectTokenResponse.Merge(m, src)
+}
+func (m *IntrospectTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntrospectTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntrospectTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntrospectTokenResponse proto.InternalMessageInfo
+
+func (m *IntrospectTokenResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *IntrospectTokenResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IntrospectTokenResponse) GetShard() int32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *IntrospectTokenResponse) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *IntrospectTokenResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *IntrospectTokenResponse) GetAdmin() bool {
+	if m != nil {
+		return m.Admin
+	}
+	return false
+}
+
+func (m *IntrospectTokenResponse) GetShardCount() int32 {
+	if m != nil {
+		return m.ShardCount
+	}
+	return 0
+}
+
+type ChannelOptions struct {
+	// send a summary of the stream when it ends
+	OfflineSummary       bool     `protobuf:"varint,1,opt,name=offline_summary,json=offlineSummary,proto3" json:"offline_summary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelOptions) Reset()         { *m = ChannelOptions{} }
+func (m *ChannelOptions) String() string { return proto.CompactTextString(m) }
+func (*ChannelOptions) ProtoMessage()    {}
+func (*ChannelOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{18}
+}
+func (m *ChannelOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelOptions.Merge(m, src)
+}
+func (m *ChannelOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelOptions proto.InternalMessageInfo
+
+func (m *ChannelOptions) GetOfflineSummary() bool {
+	if m != nil {
+		return m.OfflineSummary
+	}
+	return false
+}
+
+type GetChannelOptionsRequest struct {
+	// discord channel id
+	Channel              string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetChannelOptionsRequest) Reset()         { *m = GetChannelOptionsRequest{} }
+func (m *GetChannelOptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetChannelOptionsRequest) ProtoMessage()    {}
+func (*GetChannelOptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{19}
+}
+func (m *GetChannelOptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetChannelOptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetChannelOptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetChannelOptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChannelOptionsRequest.Merge(m, src)
+}
+func (m *GetChannelOptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetChannelOptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChannelOptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChannelOptionsRequest proto.InternalMessageInfo
+
+func (m *GetChannelOptionsRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+type SetChannelOptionsRequest struct {
+	// discord channel id
+	Channel              string          `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Options              *ChannelOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetChannelOptionsRequest) Reset()         { *m = SetChannelOptionsRequest{} }
+func (m *SetChannelOptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetChannelOptionsRequest) ProtoMessage()    {}
+func (*SetChannelOptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{20}
+}
+func (m *SetChannelOptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetChannelOptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetChannelOptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetChannelOptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetChannelOptionsRequest.Merge(m, src)
+}
+func (m *SetChannelOptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetChannelOptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetChannelOptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetChannelOptionsRequest proto.InternalMessageInfo
+
+func (m *SetChannelOptionsRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *SetChannelOptionsRequest) GetOptions() *ChannelOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type SubscriptionOptions struct {
+	// send a message when the title or game of the stream changes
+	StreamUpdates        bool     `protobuf:"varint,1,opt,name=stream_updates,json=streamUpdates,proto3" json:"stream_updates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscriptionOptions) Reset()         { *m = SubscriptionOptions{} }
+func (m *SubscriptionOptions) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionOptions) ProtoMessage()    {}
+func (*SubscriptionOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{21}
+}
+func (m *SubscriptionOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriptionOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscriptionOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionOptions.Merge(m, src)
+}
+func (m *SubscriptionOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriptionOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionOptions proto.InternalMessageInfo
+
+func (m *SubscriptionOptions) GetStreamUpdates() bool {
+	if m != nil {
+		return m.StreamUpdates
+	}
+	return false
+}
+
+type GetSubscriptionOptionsRequest struct {
+	// discord channel id
+	Channel              string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Twitchname           string   `protobuf:"bytes,2,opt,name=twitchname,proto3" json:"twitchname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSubscriptionOptionsRequest) Reset()         { *m = GetSubscriptionOptionsRequest{} }
+func (m *GetSubscriptionOptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSubscriptionOptionsRequest) ProtoMessage()    {}
+func (*GetSubscriptionOptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{22}
+}
+func (m *GetSubscriptionOptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSubscriptionOptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSubscriptionOptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSubscriptionOptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSubscriptionOptionsRequest.Merge(m, src)
+}
+func (m *GetSubscriptionOptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSubscriptionOptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSubscriptionOptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSubscriptionOptionsRequest proto.InternalMessageInfo
+
+func (m *GetSubscriptionOptionsRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *GetSubscriptionOptionsRequest) GetTwitchname() string {
+	if m != nil {
+		return m.Twitchname
+	}
+	return ""
+}
+
+type SetSubscriptionOptionsRequest struct {
+	// discord channel id
+	Channel              string               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Twitchname           string               `protobuf:"bytes,2,opt,name=twitchname,proto3" json:"twitchname,omitempty"`
+	Options              *SubscriptionOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SetSubscriptionOptionsRequest) Reset()         { *m = SetSubscriptionOptionsRequest{} }
+func (m *SetSubscriptionOptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetSubscriptionOptionsRequest) ProtoMessage()    {}
+func (*SetSubscriptionOptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{23}
+}
+func (m *SetSubscriptionOptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetSubscriptionOptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetSubscriptionOptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetSubscriptionOptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSubscriptionOptionsRequest.Merge(m, src)
+}
+func (m *SetSubscriptionOptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetSubscriptionOptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSubscriptionOptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetSubscriptionOptionsRequest proto.InternalMessageInfo
+
+func (m *SetSubscriptionOptionsRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *SetSubscriptionOptionsRequest) GetTwitchname() string {
+	if m != nil {
+		return m.Twitchname
+	}
+	return ""
+}
+
+func (m *SetSubscriptionOptionsRequest) GetOptions() *SubscriptionOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type GetShardSubscriptionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShardSubscriptionsRequest) Reset()         { *m = GetShardSubscriptionsRequest{} }
+func (m *GetShardSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShardSubscriptionsRequest) ProtoMessage()    {}
+func (*GetShardSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{24}
+}
+func (m *GetShardSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetShardSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetShardSubscriptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetShardSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardSubscriptionsRequest.Merge(m, src)
+}
+func (m *GetShardSubscriptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetShardSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardSubscriptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardSubscriptionsRequest proto.InternalMessageInfo
+
+type Subscription struct {
+	// discord channel id
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// discord guild id
+	Guild string `protobuf:"bytes,2,opt,name=guild,proto3" json:"guild,omitempty"`
+	// twitch username
+	Twitchname           string   `protobuf:"bytes,3,opt,name=twitchname,proto3" json:"twitchname,omitempty"`
+	Webhook              *Webhook `protobuf:"bytes,4,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{25}
+}
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
+}
+func (m *Subscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
+
+func (m *Subscription) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *Subscription) GetGuild() string {
+	if m != nil {
+		return m.Guild
+	}
+	return ""
+}
+
+func (m *Subscription) GetTwitchname() string {
+	if m != nil {
+		return m.Twitchname
+	}
+	return ""
+}
+
+func (m *Subscription) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+type GetShardSubscriptionsResponse struct {
+	Subscriptions        []*Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetShardSubscriptionsResponse) Reset()         { *m = GetShardSubscriptionsResponse{} }
+func (m *GetShardSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShardSubscriptionsResponse) ProtoMessage()    {}
+func (*GetShardSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{26}
+}
+func (m *GetShardSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetShardSubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetShardSubscriptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetShardSubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardSubscriptionsResponse.Merge(m, src)
+}
+func (m *GetShardSubscriptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetShardSubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardSubscriptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardSubscriptionsResponse proto.InternalMessageInfo
+
+func (m *GetShardSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+type InvalidateUserRequest struct {
+	// twitch username
+	Twitchname           string   `protobuf:"bytes,1,opt,name=twitchname,proto3" json:"twitchname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvalidateUserRequest) Reset()         { *m = InvalidateUserRequest{} }
+func (m *InvalidateUserRequest) String() string { return proto.CompactTextString(m) }
+func (*InvalidateUserRequest) ProtoMessage()    {}
+func (*InvalidateUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{27}
+}
+func (m *InvalidateUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvalidateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvalidateUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvalidateUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidateUserRequest.Merge(m, src)
+}
+func (m *InvalidateUserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvalidateUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidateUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidateUserRequest proto.InternalMessageInfo
+
+func (m *InvalidateUserRequest) GetTwitchname() string {
+	if m != nil {
+		return m.Twitchname
+	}
+	return ""
+}
+
+type InvalidateUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvalidateUserResponse) Reset()         { *m = InvalidateUserResponse{} }
+func (m *InvalidateUserResponse) String() string { return proto.CompactTextString(m) }
+func (*InvalidateUserResponse) ProtoMessage()    {}
+func (*InvalidateUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5baf264659b5c94, []int{28}
+}
+func (m *InvalidateUserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvalidateUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvalidateUserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvalidateUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidateUserResponse.Merge(m, src)
+}
+func (m *InvalidateUserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvalidateUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidateUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidateUserResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GetChannelsRequest)(nil), "twitch.GetChannelsRequest")
+	proto.RegisterType((*GetChannelsResponse)(nil), "twitch.GetChannelsResponse")
+	proto.RegisterType((*Webhook)(nil), "twitch.Webhook")
+	proto.RegisterType((*NewWebhookRequest)(nil), "twitch.NewWebhookRequest")
+	proto.RegisterType((*NewWebhookResponse)(nil), "twitch.NewWebhookResponse")
+	proto.RegisterType((*DeleteWebhookRequest)(nil), "twitch.DeleteWebhookRequest")
+	proto.RegisterType((*DeleteWebhookResponse)(nil), "twitch.DeleteWebhookResponse")
+	proto.RegisterType((*WatchEventsRequest)(nil), "twitch.WatchEventsRequest")
+	proto.RegisterType((*Stream)(nil), "twitch.Stream")
+	proto.RegisterType((*StreamOnline)(nil), "twitch.StreamOnline")
+	proto.RegisterType((*StreamOffline)(nil), "twitch.StreamOffline")
+	proto.RegisterType((*StreamUpdated)(nil), "twitch.StreamUpdated")
+	proto.RegisterType((*Event)(nil), "twitch.Event")
+	proto.RegisterType((*IssueTokenRequest)(nil), "twitch.IssueTokenRequest")
+	proto.RegisterType((*RefreshTokenRequest)(nil), "twitch.RefreshTokenRequest")
+	proto.RegisterType((*TokenResponse)(nil), "twitch.TokenResponse")
+	proto.RegisterType((*IntrospectTokenRequest)(nil), "twitch.IntrospectTokenRequest")
+	proto.RegisterType((*IntrospectTokenResponse)(nil), "twitch.IntrospectTokenResponse")
+	proto.RegisterType((*ChannelOptions)(nil), "twitch.ChannelOptions")
+	proto.RegisterType((*GetChannelOptionsRequest)(nil), "twitch.GetChannelOptionsRequest")
+	proto.RegisterType((*SetChannelOptionsRequest)(nil), "twitch.SetChannelOptionsRequest")
+	proto.RegisterType((*SubscriptionOptions)(nil), "twitch.SubscriptionOptions")
+	proto.RegisterType((*GetSubscriptionOptionsRequest)(nil), "twitch.GetSubscriptionOptionsRequest")
+	proto.RegisterType((*SetSubscriptionOptionsRequest)(nil), "twitch.SetSubscriptionOptionsRequest")
+	proto.RegisterType((*GetShardSubscriptionsRequest)(nil), "twitch.GetShardSubscriptionsRequest")
+	proto.RegisterType((*Subscription)(nil), "twitch.Subscription")
+	proto.RegisterType((*GetShardSubscriptionsResponse)(nil), "twitch.GetShardSubscriptionsResponse")
+	proto.RegisterType((*InvalidateUserRequest)(nil), "twitch.InvalidateUserRequest")
+	proto.RegisterType((*InvalidateUserResponse)(nil), "twitch.InvalidateUserResponse")
+}
+
+func init() { proto.RegisterFile("twitch.proto", fileDescriptor_a5baf264659b5c94) }
+
+var fileDescriptor_a5baf264659b5c94 = []byte{
+	// 1161 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0xe3, 0xc4,
+	0x17, 0x8f, 0xf3, 0x61, 0xa7, 0x27, 0x4d, 0xfa, 0xef, 0x34, 0x4d, 0xfd, 0x77, 0xb7, 0xd9, 0x68,
+	0xd8, 0x42, 0x01, 0xa9, 0x2c, 0xe5, 0x63, 0x25, 0xb4, 0x17, 0x74, 0xb7, 0xab, 0x6d, 0xb9, 0xd8,
+	0x82, 0xd3, 0x6a, 0x05, 0x48, 0x44, 0x6e, 0x32, 0xdd, 0x58, 0x9b, 0xd8, 0xc1, 0x33, 0x4e, 0xe1,
+	0x11, 0xb8, 0x41, 0xfb, 0x0c, 0x3c, 0x0a, 0x57, 0x5c, 0x21, 0x9e, 0x00, 0xa1, 0xf2, 0x22, 0xc8,
+	0x33, 0x63, 0x67, 0x62, 0x3b, 0xcd, 0x56, 0x0b, 0x77, 0x39, 0x1f, 0xf3, 0xf3, 0xef, 0x9c, 0x39,
+	0xe7, 0xcc, 0x09, 0xac, 0xb2, 0x2b, 0x97, 0xf5, 0x87, 0xfb, 0x93, 0xc0, 0x67, 0x3e, 0xd2, 0x85,
+	0x84, 0xef, 0x01, 0x7a, 0x4a, 0xd8, 0xe3, 0xa1, 0xe3, 0x79, 0x64, 0x44, 0x6d, 0xf2, 0x7d, 0x48,
+	0x28, 0x43, 0x0d, 0x28, 0xba, 0x03, 0x53, 0xeb, 0x68, 0x7b, 0x2b, 0x76, 0xd1, 0x1d, 0xe0, 0x33,
+	0xd8, 0x98, 0xf3, 0xa2, 0x13, 0xdf, 0xa3, 0x04, 0x21, 0x28, 0x7b, 0xce, 0x98, 0x98, 0x5a, 0xa7,
+	0xb4, 0xb7, 0x62, 0xf3, 0xdf, 0xe8, 0x5d, 0x30, 0xae, 0xc8, 0xc5, 0xd0, 0xf7, 0x5f, 0x9a, 0xc5,
+	0x8e, 0xb6, 0x57, 0x3b, 0x58, 0xdb, 0x97, 0x1f, 0x7e, 0x2e, 0xd4, 0x76, 0x6c, 0xc7, 0x1f, 0x80,
+	0x21, 0x75, 0xe9, 0x0f, 0xa2, 0x26, 0x54, 0x98, 0xff, 0x92, 0x78, 0x1c, 0x63, 0xc5, 0x16, 0x02,
+	0xfe, 0x59, 0x83, 0xf5, 0x67, 0xe4, 0x2a, 0x06, 0x92, 0x64, 0x4d, 0x30, 0xfa, 0x82, 0x99, 0x04,
+	0x88, 0x45, 0xd4, 0x06, 0x10, 0xdf, 0xe6, 0x2c, 0x05, 0x94, 0xa2, 0x51, 0xb9, 0x96, 0x6e, 0xe6,
+	0x1a, 0x11, 0x7a, 0x11, 0xba, 0xa3, 0x81, 0x59, 0x16, 0x84, 0xb8, 0x80, 0x9b, 0x80, 0x54, 0x3e,
+	0x22, 0x2d, 0xf8, 0x4b, 0x68, 0x1e, 0x91, 0x11, 0x61, 0xe4, 0xdf, 0x22, 0x8a, 0xb7, 0x60, 0x33,
+	0x85, 0x28, 0x3f, 0x65, 0x03, 0x7a, 0xee, 0xb0, 0xfe, 0xf0, 0xc9, 0x94, 0x78, 0x2c, 0xb9, 0x3e,
+	0x0b, 0xaa, 0x12, 0x99, 0xca, 0xbb, 0x49, 0x64, 0xd4, 0x81, 0xda, 0x0c, 0x98, 0x9a, 0x45, 0x6e,
+	0x56, 0x55, 0xf8, 0x4f, 0x0d, 0xf4, 0x2e, 0x0b, 0x88, 0x33, 0xce, 0x5c, 0xcb, 0x16, 0x18, 0x21,
+	0x25, 0x41, 0xcf, 0x1d, 0x48, 0x92, 0x7a, 0x24, 0x9e, 0x0c, 0x52, 0x01, 0x94, 0x32, 0x99, 0xde,
+	0x02, 0xe3, 0x85, 0x33, 0x26, 0x3d, 0x37, 0x4e, 0xa0, 0x1e, 0x89, 0x27, 0xe2, 0xa2, 0x5d, 0x36,
+	0x22, 0x66, 0x45, 0x5e, 0x74, 0x24, 0x44, 0x99, 0x9a, 0xba, 0xe4, 0x8a, 0x04, 0xd4, 0xd4, 0x3b,
+	0xda, 0x5e, 0xc5, 0x8e, 0x45, 0xb4, 0x03, 0x40, 0x99, 0x13, 0x30, 0x32, 0xe8, 0x39, 0xcc, 0x34,
+	0x3a, 0xda, 0x5e, 0xc9, 0x5e, 0x91, 0x9a, 0x43, 0x86, 0xde, 0x82, 0x3a, 0x1b, 0x86, 0xe3, 0x0b,
+	0xcf, 0x71, 0x47, 0xbd, 0x30, 0x18, 0x99, 0x55, 0x0e, 0xbb, 0x9a, 0x28, 0xcf, 0x83, 0x11, 0xfe,
+	0x14, 0x56, 0x45, 0x7c, 0xa7, 0xde, 0xc8, 0xf5, 0x08, 0x7a, 0x1b, 0x74, 0xca, 0x65, 0x1e, 0x69,
+	0xed, 0xa0, 0x11, 0x57, 0x81, 0xf0, 0xb2, 0xa5, 0x15, 0x3f, 0x80, 0xba, 0x3c, 0x77, 0x79, 0x79,
+	0xab, 0x83, 0xfd, 0xf8, 0xe0, 0xf9, 0x64, 0xe0, 0x30, 0x32, 0x78, 0xdd, 0x83, 0xe8, 0x3d, 0xa8,
+	0x4e, 0x02, 0x32, 0x75, 0xfd, 0x90, 0x9a, 0xc5, 0x5c, 0xcf, 0xc4, 0x8e, 0x7f, 0xd5, 0xa0, 0xc2,
+	0xcb, 0xe0, 0xc6, 0xeb, 0xdf, 0x07, 0xdd, 0xe7, 0x51, 0x4b, 0xbc, 0xe6, 0x3c, 0x9e, 0xc8, 0xc8,
+	0x71, 0xc1, 0x96, 0x5e, 0xe8, 0x43, 0x30, 0x7c, 0x11, 0xad, 0x6c, 0x91, 0xcd, 0xd4, 0x01, 0x61,
+	0x3c, 0x2e, 0xd8, 0xb1, 0x5f, 0x74, 0x24, 0x14, 0x71, 0x9a, 0xe5, 0xbc, 0x23, 0x32, 0x09, 0xd1,
+	0x11, 0xe9, 0xf7, 0xc8, 0x80, 0x0a, 0x89, 0xa8, 0xe3, 0x29, 0xac, 0x9f, 0x50, 0x1a, 0x92, 0xb3,
+	0xa8, 0xdf, 0xe3, 0x72, 0x9e, 0x8d, 0x19, 0x2d, 0x19, 0x33, 0x4d, 0xa8, 0xd0, 0xa1, 0x13, 0x88,
+	0x3a, 0xac, 0xd8, 0x42, 0x40, 0x2d, 0xd0, 0x29, 0xe9, 0x07, 0x84, 0xc9, 0x12, 0x94, 0x12, 0xba,
+	0x0b, 0x35, 0xee, 0xd0, 0xeb, 0xfb, 0xa1, 0xc7, 0x38, 0xad, 0x8a, 0x0d, 0x5c, 0xf5, 0x38, 0xd2,
+	0xe0, 0xf7, 0x61, 0xc3, 0x26, 0x97, 0x01, 0xa1, 0xc3, 0xb9, 0x2f, 0x27, 0x63, 0x48, 0x53, 0xc7,
+	0xd0, 0x11, 0xd4, 0xa5, 0x97, 0x9c, 0x83, 0xb9, 0x6e, 0x51, 0xa9, 0x92, 0x1f, 0x26, 0x6e, 0x40,
+	0x68, 0x54, 0xaa, 0x45, 0x51, 0xaa, 0x52, 0x73, 0xc8, 0xf0, 0x3e, 0xb4, 0x4e, 0x3c, 0x16, 0xf8,
+	0x74, 0x42, 0xfa, 0xec, 0x35, 0xbe, 0xfa, 0xbb, 0x06, 0x5b, 0x99, 0x03, 0x92, 0x40, 0x0b, 0x74,
+	0xa7, 0xcf, 0xdc, 0xa9, 0xc8, 0x51, 0xd5, 0x96, 0x52, 0x92, 0xb9, 0x62, 0x5e, 0xe6, 0x4a, 0x6a,
+	0xe6, 0xb6, 0x61, 0xc5, 0x8d, 0x12, 0xcf, 0xdb, 0xaa, 0xcc, 0xb9, 0x56, 0x85, 0xe2, 0x90, 0xa5,
+	0x22, 0xa9, 0xa4, 0x22, 0x89, 0x10, 0x9d, 0xc1, 0xd8, 0xf5, 0x78, 0xaf, 0x56, 0x6d, 0x21, 0xa4,
+	0x73, 0x6e, 0x64, 0x72, 0x7e, 0x0a, 0x0d, 0xf9, 0xa2, 0x9c, 0x4e, 0x98, 0xeb, 0x7b, 0x14, 0xbd,
+	0x03, 0x6b, 0xb2, 0x88, 0x7a, 0x34, 0x1c, 0x8f, 0x9d, 0xe0, 0x47, 0x19, 0x4f, 0x43, 0xaa, 0xbb,
+	0x42, 0xfb, 0x45, 0xb9, 0x5a, 0xfc, 0x5f, 0xc9, 0x6e, 0x88, 0x2e, 0xe9, 0x89, 0x2a, 0xa2, 0xf8,
+	0x63, 0x30, 0x67, 0xaf, 0x94, 0xc4, 0x5c, 0x3a, 0x7b, 0xf1, 0x25, 0x98, 0xdd, 0x5b, 0x9f, 0x42,
+	0xf7, 0xc1, 0xf0, 0x85, 0xaf, 0x6c, 0xa4, 0x56, 0x5c, 0xe4, 0x29, 0xa4, 0xd8, 0x0d, 0x3f, 0x84,
+	0x8d, 0x6e, 0x78, 0x41, 0xfb, 0x81, 0xcb, 0x15, 0x71, 0xcc, 0xbb, 0x90, 0x0a, 0x43, 0x86, 0x5c,
+	0xa7, 0x4a, 0xb3, 0x50, 0xfc, 0x35, 0xec, 0x3c, 0x25, 0x2c, 0x07, 0xe0, 0xcd, 0x1f, 0x97, 0x57,
+	0x1a, 0xec, 0x74, 0xff, 0x1b, 0x6c, 0xf4, 0xc9, 0x2c, 0x4d, 0x62, 0x7c, 0x6c, 0x27, 0xb3, 0x20,
+	0xe7, 0x73, 0x49, 0xae, 0xda, 0x70, 0x27, 0x8a, 0x36, 0xaa, 0x15, 0xd5, 0x2f, 0x26, 0x84, 0x7f,
+	0xd2, 0x60, 0x55, 0x35, 0xdc, 0xc0, 0x30, 0x79, 0xb8, 0x8b, 0xca, 0xc3, 0xbd, 0xf4, 0xbd, 0x52,
+	0x36, 0x83, 0xf2, 0x92, 0x2d, 0xe6, 0x5b, 0xd8, 0x59, 0xc0, 0x55, 0x36, 0xe7, 0x67, 0x50, 0xa7,
+	0xaa, 0x81, 0xcf, 0x64, 0x75, 0xf2, 0x2a, 0x46, 0x7b, 0xde, 0x15, 0x3f, 0x80, 0xcd, 0x13, 0x6f,
+	0xea, 0x8c, 0xdc, 0xa8, 0x0a, 0xce, 0x29, 0x09, 0xe2, 0x2b, 0x99, 0x0f, 0x40, 0xcb, 0x5c, 0xaa,
+	0x09, 0xad, 0xf4, 0x41, 0x41, 0xe7, 0xe0, 0x97, 0x2a, 0xe8, 0x67, 0xdc, 0x11, 0x1d, 0x43, 0x4d,
+	0x59, 0xeb, 0x90, 0x15, 0x33, 0xca, 0x6e, 0x84, 0xd6, 0x76, 0xae, 0x4d, 0x6e, 0x21, 0x05, 0xf4,
+	0x04, 0x60, 0xb6, 0x08, 0xa1, 0xff, 0xc7, 0xce, 0x99, 0x65, 0xcd, 0xb2, 0xf2, 0x4c, 0x09, 0xcc,
+	0x33, 0xa8, 0xcf, 0xed, 0x39, 0xe8, 0x4e, 0xec, 0x9e, 0xb7, 0x50, 0x59, 0x3b, 0x0b, 0xac, 0x09,
+	0xde, 0x43, 0xa8, 0x29, 0xeb, 0xd1, 0x2c, 0xc0, 0xec, 0xce, 0x64, 0xd5, 0x63, 0x1b, 0x57, 0xe3,
+	0xc2, 0x7d, 0x0d, 0x9d, 0xc2, 0x7a, 0x66, 0x9e, 0xa0, 0x4e, 0x36, 0x11, 0xf3, 0xdd, 0x62, 0x2d,
+	0x98, 0x04, 0xb8, 0x10, 0x01, 0x76, 0x17, 0x03, 0x76, 0x6f, 0x0f, 0xf8, 0x1d, 0xb4, 0xf2, 0xa7,
+	0x02, 0xda, 0x55, 0x68, 0x2e, 0xee, 0x6c, 0xeb, 0xa6, 0x76, 0x14, 0xf8, 0xdd, 0x25, 0xf8, 0xdd,
+	0x37, 0xc1, 0xbf, 0x84, 0xcd, 0xdc, 0xde, 0x41, 0xf7, 0x54, 0xfa, 0x8b, 0xc6, 0x80, 0xb5, 0xbb,
+	0xc4, 0x2b, 0xa9, 0x83, 0xaf, 0xa0, 0x31, 0xdf, 0x0d, 0x28, 0x29, 0x9d, 0xdc, 0xf6, 0xb2, 0xda,
+	0x8b, 0xcc, 0x09, 0xe4, 0xe7, 0x00, 0xb3, 0x4d, 0x65, 0x56, 0xf1, 0x99, 0xed, 0xc5, 0x4a, 0xb6,
+	0x9f, 0xb9, 0x27, 0x1b, 0x17, 0xd0, 0x11, 0xac, 0xaa, 0x3b, 0x07, 0x4a, 0x72, 0x95, 0xb3, 0x89,
+	0x2c, 0x46, 0x39, 0x83, 0xb5, 0xd4, 0x56, 0x80, 0x14, 0xf2, 0x79, 0xfb, 0x85, 0x75, 0x77, 0xa1,
+	0x3d, 0x46, 0x7d, 0xd4, 0xfc, 0xed, 0xba, 0xad, 0xfd, 0x71, 0xdd, 0xd6, 0xfe, 0xba, 0x6e, 0x6b,
+	0xaf, 0xfe, 0x6e, 0x17, 0xbe, 0x29, 0x4e, 0x2e, 0x2e, 0x74, 0xfe, 0xdf, 0xf1, 0xa3, 0x7f, 0x06,
+	0x00, 0xec, 0xa8, 0x05, 0x61, 0x4b, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TwitchClient is the client API for Twitch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TwitchClient interface {
+	GetChannels(ctx context.Context, in *GetChannelsRequest, opts ...grpc.CallOption) (*GetChannelsResponse, error)
+	NewWebhook(ctx context.Context, in *NewWebhookRequest, opts ...grpc.CallOption) (*NewWebhookResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (Twitch_WatchEventsClient, error)
+	GetChannelOptions(ctx context.Context, in *GetChannelOptionsRequest, opts ...grpc.CallOption) (*ChannelOptions, error)
+	SetChannelOptions(ctx context.Context, in *SetChannelOptionsRequest, opts ...grpc.CallOption) (*ChannelOptions, error)
+	GetSubscriptionOptions(ctx context.Context, in *GetSubscriptionOptionsRequest, opts ...grpc.CallOption) (*SubscriptionOptions, error)
+	SetSubscriptionOptions(ctx context.Context, in *SetSubscriptionOptionsRequest, opts ...grpc.CallOption) (*SubscriptionOptions, error)
+	// returns the subscriptions in guilds handled by the token's shard
+	GetShardSubscriptions(ctx context.Context, in *GetShardSubscriptionsRequest, opts ...grpc.CallOption) (*GetShardSubscriptionsResponse, error)
+	// forgets the cached info of a twitch user so it's requested again
+	InvalidateUser(ctx context.Context, in *InvalidateUserRequest, opts ...grpc.CallOption) (*InvalidateUserResponse, error)
+	// the token rpcs don't require authorization
+	IssueToken(ctx context.Context, in *IssueTokenRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	IntrospectToken(ctx context.Context, in *IntrospectTokenRequest, opts ...grpc.CallOption) (*IntrospectTokenResponse, error)
+}
+
+type twitchClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTwitchClient(cc *grpc.ClientConn) TwitchClient {
+	return &twitchClient{cc}
+}
+
+func (c *twitchClient) GetChannels(ctx context.Context, in *GetChannelsRequest, opts ...grpc.CallOption) (*GetChannelsResponse, error) {
+	out := new(GetChannelsResponse)
+	err := c.cc.Invoke(ctx, "/twitch.Twitch/GetChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitchClient) NewWebhook(ctx context.Context, in *NewWebhookRequest, opts ...grpc.CallOption) (*NewWebhookResponse, error) {
+	out := new(NewWebhookResponse)
+	err := c.cc.Invoke(ctx, "/twitch.Twitch/NewWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitchClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, "/twitch.Twitch/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitchClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (Twitch_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Twitch_serviceDesc.Streams[0], "/twitch.Twitch/WatchEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &twitchWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Twitch_WatchEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type twitchWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *twitchWatchEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *twitchClient) GetChannelOptions(ctx context.Context, in *GetChannelOptionsRequest, opts ...grpc.CallOption) (*ChannelOptions, error) {
+	out := new(ChannelOptions)
+	err := c.cc.Invoke(ctx, "/twitch.Twitch/GetChannelOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitchClient) SetChannelOptions(ctx context.Context, in *SetChannelOptionsRequest, opts ...grpc.CallOption) (*ChannelOptions, error) {
+	out := new(ChannelOptions)
+	err := c.cc.Invoke(ctx, "/twitch.Twitch/SetChannelOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitchClient) GetSubscriptionOptions(ctx context.Context, in *GetSubscriptionOptionsRequest, opts ...grpc.CallOption) (*SubscriptionOptions, error) {
+	out := new(SubscriptionOptions)
+	err := c.cc.Invoke(ctx, "/twitch.Twitch/GetSubscriptionOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitchClient) SetSubscriptionOptions(ctx context.Context, in *SetSubscriptionOptionsRequest, opts ...grpc.CallOption) (*SubscriptionOptions, error) {
+	out := new(SubscriptionOptions)
+	err := c.cc.Invoke(ctx, "/twitch.Twitch/SetSubscriptionOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitchClient) GetShardSubscriptions(ctx context.Context, in *GetShardSubscriptionsRequest, opts ...grpc.CallOption) (*GetShardSubscriptionsResponse, error) {
+	out := new(GetShardSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/twitch.Twitch/GetShardSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitchClient) InvalidateUser(ctx context.Context, in *InvalidateUserRequest, opts ...grpc.CallOption) (*InvalidateUserResponse, error) {
+	out := new(InvalidateUserResponse)
+	err := c.cc.Invoke(ctx, "/twitch.Twitch/InvalidateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitchClient) IssueToken(ctx context.Context, in *IssueTokenRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, "/twitch.Twitch/IssueToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitchClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, "/twitch.Twitch/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitchClient) IntrospectToken(ctx context.Context, in *IntrospectTokenRequest, opts ...grpc.CallOption) (*IntrospectTokenResponse, error) {
+	out := new(IntrospectTokenResponse)
+	err := c.cc.Invoke(ctx, "/twitch.Twitch/IntrospectToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TwitchServer is the server API for Twitch service.
+type TwitchServer interface {
+	GetChannels(context.Context, *GetChannelsRequest) (*GetChannelsResponse, error)
+	NewWebhook(context.Context, *NewWebhookRequest) (*NewWebhookResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	WatchEvents(*WatchEventsRequest, Twitch_WatchEventsServer) error
+	GetChannelOptions(context.Context, *GetChannelOptionsRequest) (*ChannelOptions, error)
+	SetChannelOptions(context.Context, *SetChannelOptionsRequest) (*ChannelOptions, error)
+	GetSubscriptionOptions(context.Context, *GetSubscriptionOptionsRequest) (*SubscriptionOptions, error)
+	SetSubscriptionOptions(context.Context, *SetSubscriptionOptionsRequest) (*SubscriptionOptions, error)
+	// returns the subscriptions in guilds handled by the token's shard
+	GetShardSubscriptions(context.Context, *GetShardSubscriptionsRequest) (*GetShardSubscriptionsResponse, error)
+	// forgets the cached info of a twitch user so it's requested again
+	InvalidateUser(context.Context, *InvalidateUserRequest) (*InvalidateUserResponse, error)
+	// the token rpcs don't require authorization
+	IssueToken(context.Context, *IssueTokenRequest) (*TokenResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*TokenResponse, error)
+	IntrospectToken(context.Context, *IntrospectTokenRequest) (*IntrospectTokenResponse, error)
+}
+
+// UnimplementedTwitchServer can be embedded to have forward compatible implementations.
+type UnimplementedTwitchServer struct {
+}
+
+func (*UnimplementedTwitchServer) GetChannels(ctx context.Context, req *GetChannelsRequest) (*GetChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannels not implemented")
+}
+func (*UnimplementedTwitchServer) NewWebhook(ctx context.Context, req *NewWebhookRequest) (*NewWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewWebhook not implemented")
+}
+func (*UnimplementedTwitchServer) DeleteWebhook(ctx context.Context, req *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (*UnimplementedTwitchServer) WatchEvents(req *WatchEventsRequest, srv Twitch_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (*UnimplementedTwitchServer) GetChannelOptions(ctx context.Context, req *GetChannelOptionsRequest) (*ChannelOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelOptions not implemented")
+}
+func (*UnimplementedTwitchServer) SetChannelOptions(ctx context.Context, req *SetChannelOptionsRequest) (*ChannelOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelOptions not implemented")
+}
+func (*UnimplementedTwitchServer) GetSubscriptionOptions(ctx context.Context, req *GetSubscriptionOptionsRequest) (*SubscriptionOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscriptionOptions not implemented")
+}
+func (*UnimplementedTwitchServer) SetSubscriptionOptions(ctx context.Context, req *SetSubscriptionOptionsRequest) (*SubscriptionOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSubscriptionOptions not implemented")
+}
+func (*UnimplementedTwitchServer) GetShardSubscriptions(ctx context.Context, req *GetShardSubscriptionsRequest) (*GetShardSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardSubscriptions not implemented")
+}
+func (*UnimplementedTwitchServer) InvalidateUser(ctx context.Context, req *InvalidateUserRequest) (*InvalidateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateUser not implemented")
+}
+func (*UnimplementedTwitchServer) IssueToken(ctx context.Context, req *IssueTokenRequest) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueToken not implemented")
+}
+func (*UnimplementedTwitchServer) RefreshToken(ctx context.Context, req *RefreshTokenRequest) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (*UnimplementedTwitchServer) IntrospectToken(ctx context.Context, req *IntrospectTokenRequest) (*IntrospectTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntrospectToken not implemented")
+}
+
+func RegisterTwitchServer(s *grpc.Server, srv TwitchServer) {
+	s.RegisterService(&_Twitch_serviceDesc, srv)
+}
+
+func _Twitch_GetChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitchServer).GetChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitch.Twitch/GetChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitchServer).GetChannels(ctx, req.(*GetChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitch_NewWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitchServer).NewWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitch.Twitch/NewWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitchServer).NewWebhook(ctx, req.(*NewWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitch_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitchServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitch.Twitch/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitchServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitch_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TwitchServer).WatchEvents(m, &twitchWatchEventsServer{stream})
+}
+
+type Twitch_WatchEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type twitchWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *twitchWatchEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Twitch_GetChannelOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitchServer).GetChannelOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitch.Twitch/GetChannelOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitchServer).GetChannelOptions(ctx, req.(*GetChannelOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitch_SetChannelOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChannelOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitchServer).SetChannelOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitch.Twitch/SetChannelOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitchServer).SetChannelOptions(ctx, req.(*SetChannelOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitch_GetSubscriptionOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitchServer).GetSubscriptionOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitch.Twitch/GetSubscriptionOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitchServer).GetSubscriptionOptions(ctx, req.(*GetSubscriptionOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitch_SetSubscriptionOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSubscriptionOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitchServer).SetSubscriptionOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitch.Twitch/SetSubscriptionOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitchServer).SetSubscriptionOptions(ctx, req.(*SetSubscriptionOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitch_GetShardSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShardSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitchServer).GetShardSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitch.Twitch/GetShardSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitchServer).GetShardSubscriptions(ctx, req.(*GetShardSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitch_InvalidateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitchServer).InvalidateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitch.Twitch/InvalidateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitchServer).InvalidateUser(ctx, req.(*InvalidateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitch_IssueToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitchServer).IssueToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitch.Twitch/IssueToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitchServer).IssueToken(ctx, req.(*IssueTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitch_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitchServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitch.Twitch/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitchServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitch_IntrospectToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntrospectTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitchServer).IntrospectToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitch.Twitch/IntrospectToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitchServer).IntrospectToken(ctx, req.(*IntrospectTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Twitch_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "twitch.Twitch",
+	HandlerType: (*TwitchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetChannels",
+			Handler:    _Twitch_GetChannels_Handler,
+		},
+		{
+			MethodName: "NewWebhook",
+			Handler:    _Twitch_NewWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Twitch_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetChannelOptions",
+			Handler:    _Twitch_GetChannelOptions_Handler,
+		},
+		{
+			MethodName: "SetChannelOptions",
+			Handler:    _Twitch_SetChannelOptions_Handler,
+		},
+		{
+			MethodName: "GetSubscriptionOptions",
+			Handler:    _Twitch_GetSubscriptionOptions_Handler,
+		},
+		{
+			MethodName: "SetSubscriptionOptions",
+			Handler:    _Twitch_SetSubscriptionOptions_Handler,
+		},
+		{
+			MethodName: "GetShardSubscriptions",
+			Handler:    _Twitch_GetShardSubscriptions_Handler,
+		},
+		{
+			MethodName: "InvalidateUser",
+			Handler:    _Twitch_InvalidateUser_Handler,
+		},
+		{
+			MethodName: "IssueToken",
+			Handler:    _Twitch_IssueToken_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Twitch_RefreshToken_Handler,
+		},
+		{
+			MethodName: "IntrospectToken",
+			Handler:    _Twitch_IntrospectToken_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _Twitch_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "twitch.proto",
+}
+
+func (m *GetChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Webhook != nil {
+		{
+			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwitch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		for iNdEx := len(m.Name) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Name[iNdEx])
+			copy(dAtA[i:], m.Name[iNdEx])
+			i = encodeVarintTwitch(dAtA, i, uint64(len(m.Name[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Webhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Webhook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Webhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NewWebhookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NewWebhookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guild) > 0 {
+		i -= len(m.Guild)
+		copy(dAtA[i:], m.Guild)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Guild)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Webhook != nil {
+		{
+			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwitch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Twitchname) > 0 {
+		i -= len(m.Twitchname)
+		copy(dAtA[i:], m.Twitchname)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Twitchname)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NewWebhookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewWebhookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NewWebhookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWebhookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWebhookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Twitchname) > 0 {
+		i -= len(m.Twitchname)
+		copy(dAtA[i:], m.Twitchname)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Twitchname)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWebhookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWebhookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWebhookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Twitchnames) > 0 {
+		for iNdEx := len(m.Twitchnames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Twitchnames[iNdEx])
+			copy(dAtA[i:], m.Twitchnames[iNdEx])
+			i = encodeVarintTwitch(dAtA, i, uint64(len(m.Twitchnames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Channels[iNdEx])
+			copy(dAtA[i:], m.Channels[iNdEx])
+			i = encodeVarintTwitch(dAtA, i, uint64(len(m.Channels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ThumbnailUrl) > 0 {
+		i -= len(m.ThumbnailUrl)
+		copy(dAtA[i:], m.ThumbnailUrl)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.ThumbnailUrl)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StartedAt != 0 {
+		i = encodeVarintTwitch(dAtA, i, uint64(m.StartedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Viewers != 0 {
+		i = encodeVarintTwitch(dAtA, i, uint64(m.Viewers))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Twitchname) > 0 {
+		i -= len(m.Twitchname)
+		copy(dAtA[i:], m.Twitchname)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Twitchname)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOnline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOnline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOnline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stream != nil {
+		{
+			size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwitch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOffline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOffline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOffline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stream != nil {
+		{
+			size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwitch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Previous != nil {
+		{
+			size, err := m.Previous.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwitch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Stream != nil {
+		{
+			size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwitch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Channels[iNdEx])
+			copy(dAtA[i:], m.Channels[iNdEx])
+			i = encodeVarintTwitch(dAtA, i, uint64(len(m.Channels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Online) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Online) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Online != nil {
+		{
+			size, err := m.Online.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwitch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Offline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Offline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Offline != nil {
+		{
+			size, err := m.Offline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwitch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Updated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Updated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwitch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *IssueTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssueTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssueTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShardCount != 0 {
+		i = encodeVarintTwitch(dAtA, i, uint64(m.ShardCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Shard != 0 {
+		i = encodeVarintTwitch(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTwitch(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntrospectTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntrospectTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntrospectTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntrospectTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntrospectTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntrospectTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShardCount != 0 {
+		i = encodeVarintTwitch(dAtA, i, uint64(m.ShardCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Admin {
+		i--
+		if m.Admin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTwitch(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IssuedAt != 0 {
+		i = encodeVarintTwitch(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Shard != 0 {
+		i = encodeVarintTwitch(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OfflineSummary {
+		i--
+		if m.OfflineSummary {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetChannelOptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetChannelOptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetChannelOptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetChannelOptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetChannelOptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetChannelOptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwitch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscriptionOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamUpdates {
+		i--
+		if m.StreamUpdates {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSubscriptionOptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSubscriptionOptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSubscriptionOptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Twitchname) > 0 {
+		i -= len(m.Twitchname)
+		copy(dAtA[i:], m.Twitchname)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Twitchname)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetSubscriptionOptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetSubscriptionOptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetSubscriptionOptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwitch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Twitchname) > 0 {
+		i -= len(m.Twitchname)
+		copy(dAtA[i:], m.Twitchname)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Twitchname)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetShardSubscriptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetShardSubscriptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetShardSubscriptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Subscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Webhook != nil {
+		{
+			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwitch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Twitchname) > 0 {
+		i -= len(m.Twitchname)
+		copy(dAtA[i:], m.Twitchname)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Twitchname)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Guild) > 0 {
+		i -= len(m.Guild)
+		copy(dAtA[i:], m.Guild)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Guild)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetShardSubscriptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetShardSubscriptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetShardSubscriptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTwitch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvalidateUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvalidateUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvalidateUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Twitchname) > 0 {
+		i -= len(m.Twitchname)
+		copy(dAtA[i:], m.Twitchname)
+		i = encodeVarintTwitch(dAtA, i, uint64(len(m.Twitchname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvalidateUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvalidateUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvalidateUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTwitch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTwitch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *GetChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		for _, s := range m.Name {
+			l = len(s)
+			n += 1 + l + sovTwitch(uint64(l))
+		}
+	}
+	if m.Webhook != nil {
+		l = m.Webhook.Size()
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *Webhook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *NewWebhookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	l = len(m.Twitchname)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	if m.Webhook != nil {
+		l = m.Webhook.Size()
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	l = len(m.Guild)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *NewWebhookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeleteWebhookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	l = len(m.Twitchname)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteWebhookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WatchEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, s := range m.Channels {
+			l = len(s)
+			n += 1 + l + sovTwitch(uint64(l))
+		}
+	}
+	if len(m.Twitchnames) > 0 {
+		for _, s := range m.Twitchnames {
+			l = len(s)
+			n += 1 + l + sovTwitch(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Stream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	l = len(m.Twitchname)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	if m.Viewers != 0 {
+		n += 1 + sovTwitch(uint64(m.Viewers))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovTwitch(uint64(m.StartedAt))
+	}
+	l = len(m.ThumbnailUrl)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamOnline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stream != nil {
+		l = m.Stream.Size()
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamOffline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stream != nil {
+		l = m.Stream.Size()
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stream != nil {
+		l = m.Stream.Size()
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	if m.Previous != nil {
+		l = m.Previous.Size()
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, s := range m.Channels {
+			l = len(s)
+			n += 1 + l + sovTwitch(uint64(l))
+		}
+	}
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	return n
+}
+
+func (m *Event_Online) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Online != nil {
+		l = m.Online.Size()
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+func (m *Event_Offline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offline != nil {
+		l = m.Offline.Size()
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+func (m *Event_Updated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+func (m *IssueTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovTwitch(uint64(m.Shard))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	if m.ShardCount != 0 {
+		n += 1 + sovTwitch(uint64(m.ShardCount))
+	}
+	return n
+}
+
+func (m *RefreshTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTwitch(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *IntrospectTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *IntrospectTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Active {
+		n += 2
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovTwitch(uint64(m.Shard))
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovTwitch(uint64(m.IssuedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTwitch(uint64(m.ExpiresAt))
+	}
+	if m.Admin {
+		n += 2
+	}
+	if m.ShardCount != 0 {
+		n += 1 + sovTwitch(uint64(m.ShardCount))
+	}
+	return n
+}
+
+func (m *ChannelOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OfflineSummary {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetChannelOptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *SetChannelOptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscriptionOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamUpdates {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetSubscriptionOptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	l = len(m.Twitchname)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *SetSubscriptionOptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	l = len(m.Twitchname)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *GetShardSubscriptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Subscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	l = len(m.Guild)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	l = len(m.Twitchname)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	if m.Webhook != nil {
+		l = m.Webhook.Size()
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *GetShardSubscriptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovTwitch(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InvalidateUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Twitchname)
+	if l > 0 {
+		n += 1 + l + sovTwitch(uint64(l))
+	}
+	return n
+}
+
+func (m *InvalidateUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTwitch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTwitch(x uint64) (n int) {
+	return sovTwitch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = &Webhook{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Webhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Webhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Webhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewWebhookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewWebhookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twitchname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Twitchname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = &Webhook{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guild", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guild = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewWebhookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewWebhookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewWebhookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteWebhookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteWebhookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twitchname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Twitchname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteWebhookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteWebhookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteWebhookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twitchnames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Twitchnames = append(m.Twitchnames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twitchname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Twitchname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Viewers", wireType)
+			}
+			m.Viewers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Viewers |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThumbnailUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThumbnailUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamOnline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamOnline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamOnline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stream == nil {
+				m.Stream = &Stream{}
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamOffline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamOffline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamOffline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stream == nil {
+				m.Stream = &Stream{}
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stream == nil {
+				m.Stream = &Stream{}
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Previous == nil {
+				m.Previous = &Stream{}
+			}
+			if err := m.Previous.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Online", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StreamOnline{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &Event_Online{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StreamOffline{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &Event_Offline{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StreamUpdated{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &Event_Updated{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IssueTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssueTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssueTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardCount", wireType)
+			}
+			m.ShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntrospectTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntrospectTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntrospectTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntrospectTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntrospectTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntrospectTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Admin = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardCount", wireType)
+			}
+			m.ShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfflineSummary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OfflineSummary = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetChannelOptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetChannelOptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetChannelOptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetChannelOptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetChannelOptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetChannelOptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &ChannelOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriptionOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamUpdates", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StreamUpdates = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSubscriptionOptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwitch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSubscriptionOptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSubscriptionOptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twitchname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Twitchname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwitch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozTwitch(x uint64) (n int) {
-	return sovTwitch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GetChannelsRequest) Unmarshal(dAtA []byte) error {
+func (m *SetSubscriptionOptionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -702,7 +6742,7 @@ func (m *GetChannelsRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -710,15 +6750,15 @@ func (m *GetChannelsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetChannelsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetSubscriptionOptionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetSubscriptionOptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -730,7 +6770,7 @@ func (m *GetChannelsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -740,10 +6780,81 @@ func (m *GetChannelsRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTwitch
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twitchname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Twitchname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &SubscriptionOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -751,7 +6862,7 @@ func (m *GetChannelsRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTwitch
 			}
 			if (iNdEx + skippy) > l {
@@ -766,7 +6877,7 @@ func (m *GetChannelsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetChannelsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetShardSubscriptionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -781,7 +6892,7 @@ func (m *GetChannelsResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -789,48 +6900,19 @@ func (m *GetChannelsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetChannelsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetShardSubscriptionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetShardSubscriptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTwitch
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTwitch
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwitch(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTwitch
 			}
 			if (iNdEx + skippy) > l {
@@ -845,7 +6927,7 @@ func (m *GetChannelsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewWebhookRequest) Unmarshal(dAtA []byte) error {
+func (m *Subscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -860,7 +6942,7 @@ func (m *NewWebhookRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -868,10 +6950,10 @@ func (m *NewWebhookRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewWebhookRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -888,7 +6970,7 @@ func (m *NewWebhookRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -898,12 +6980,47 @@ func (m *NewWebhookRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTwitch
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
 			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guild", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guild = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Twitchname", wireType)
 			}
@@ -917,7 +7034,7 @@ func (m *NewWebhookRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -927,18 +7044,57 @@ func (m *NewWebhookRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTwitch
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
 			m.Twitchname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = &Webhook{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwitch(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTwitch
 			}
 			if (iNdEx + skippy) > l {
@@ -953,7 +7109,7 @@ func (m *NewWebhookRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewWebhookResponse) Unmarshal(dAtA []byte) error {
+func (m *GetShardSubscriptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -968,7 +7124,7 @@ func (m *NewWebhookResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -976,19 +7132,53 @@ func (m *NewWebhookResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewWebhookResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetShardSubscriptionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewWebhookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetShardSubscriptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwitch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwitch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, &Subscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwitch(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTwitch
 			}
 			if (iNdEx + skippy) > l {
@@ -1003,7 +7193,7 @@ func (m *NewWebhookResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteWebhookRequest) Unmarshal(dAtA []byte) error {
+func (m *InvalidateUserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1018,7 +7208,7 @@ func (m *DeleteWebhookRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -1026,15 +7216,15 @@ func (m *DeleteWebhookRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteWebhookRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InvalidateUserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InvalidateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twitchname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1046,7 +7236,7 @@ func (m *DeleteWebhookRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1056,35 +7246,9 @@ func (m *DeleteWebhookRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTwitch
 			}
 			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Twitchname", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTwitch
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if postIndex < 0 {
 				return ErrInvalidLengthTwitch
 			}
-			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1096,7 +7260,7 @@ func (m *DeleteWebhookRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTwitch
 			}
 			if (iNdEx + skippy) > l {
@@ -1111,7 +7275,7 @@ func (m *DeleteWebhookRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteWebhookResponse) Unmarshal(dAtA []byte) error {
+func (m *InvalidateUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1126,7 +7290,7 @@ func (m *DeleteWebhookResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -1134,10 +7298,10 @@ func (m *DeleteWebhookResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteWebhookResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InvalidateUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteWebhookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InvalidateUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1146,7 +7310,7 @@ func (m *DeleteWebhookResponse) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTwitch
 			}
 			if (iNdEx + skippy) > l {
@@ -1164,6 +7328,7 @@ func (m *DeleteWebhookResponse) Unmarshal(dAtA []byte) error {
 func skipTwitch(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -1195,10 +7360,8 @@ func skipTwitch(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -1215,76 +7378,34 @@ func skipTwitch(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			iNdEx += length
 			if length < 0 {
 				return 0, ErrInvalidLengthTwitch
 			}
-			return iNdEx, nil
+			iNdEx += length
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowTwitch
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipTwitch(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTwitch
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTwitch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthTwitch = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowTwitch   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthTwitch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTwitch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTwitch = fmt.Errorf("proto: unexpected end of group")
 )
-
-func init() { proto.RegisterFile("twitch.proto", fileDescriptor_twitch_e613e3fb58de86e8) }
-
-var fileDescriptor_twitch_e613e3fb58de86e8 = []byte{
-	// 272 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0x29, 0xcf, 0x2c,
-	0x49, 0xce, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0xf0, 0x94, 0x54, 0xb8, 0x84,
-	0xdc, 0x53, 0x4b, 0x9c, 0x33, 0x12, 0xf3, 0xf2, 0x52, 0x73, 0x8a, 0x83, 0x52, 0x0b, 0x4b, 0x53,
-	0x8b, 0x4b, 0x84, 0xf8, 0xb8, 0x98, 0x32, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x98,
-	0x32, 0x53, 0x94, 0x34, 0xb9, 0x84, 0x51, 0x54, 0x15, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x0a, 0x09,
-	0x71, 0xb1, 0xe4, 0x25, 0xe6, 0xa6, 0x4a, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0x81, 0xd9, 0x4a,
-	0xbe, 0x5c, 0x82, 0x7e, 0xa9, 0xe5, 0xe1, 0xa9, 0x49, 0x19, 0xf9, 0xf9, 0xd9, 0x30, 0xf3, 0x24,
-	0xb8, 0xd8, 0x93, 0x21, 0x9a, 0xa1, 0x86, 0xc2, 0xb8, 0x42, 0x72, 0x5c, 0x5c, 0x10, 0x97, 0x80,
-	0x0d, 0x62, 0x02, 0x4b, 0x22, 0x89, 0x28, 0x89, 0x70, 0x09, 0x21, 0x1b, 0x07, 0xb1, 0x58, 0x29,
-	0x80, 0x4b, 0xc4, 0x25, 0x35, 0x27, 0xb5, 0x24, 0x95, 0x6a, 0xf6, 0x88, 0x73, 0x89, 0xa2, 0x99,
-	0x08, 0xb1, 0xca, 0xe8, 0x25, 0x23, 0x17, 0x5b, 0x08, 0x58, 0x9d, 0x90, 0x07, 0x17, 0x37, 0x52,
-	0x28, 0x08, 0x49, 0xe9, 0x41, 0x43, 0x14, 0x33, 0x00, 0xa5, 0xa4, 0xb1, 0xca, 0x41, 0x5d, 0xcf,
-	0x20, 0xe4, 0xca, 0xc5, 0x85, 0xf0, 0x95, 0x90, 0x24, 0x4c, 0x31, 0x46, 0xc0, 0x49, 0x49, 0x61,
-	0x93, 0x82, 0x1b, 0xe3, 0xc7, 0xc5, 0x8b, 0xe2, 0x68, 0x21, 0x19, 0x98, 0x72, 0x6c, 0xa1, 0x23,
-	0x25, 0x8b, 0x43, 0x16, 0x66, 0x9e, 0x93, 0xc8, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
-	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0x43, 0x14, 0x53, 0x41, 0x52, 0x12, 0x1b, 0x38,
-	0xc5, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xc0, 0xc9, 0x67, 0x41, 0x02, 0x00, 0x00,
-}
@@ -0,0 +1,18 @@
+package twitch
+
+// Cache stores twitch users and games so they don't have to be
+// requested for every message. Database caches them in bolt, and
+// PostgresCache in the twitch_user and games tables
+type Cache interface {
+	// GetCachedUsers returns the cached users out of ids, including expired ones
+	GetCachedUsers(ids []string) (map[string]*CachedUser, error)
+	// CacheUsers caches users along with the ids twitch didn't return
+	CacheUsers(users []*UserData, missing []string) error
+	// InvalidateUser removes a user from the cache by their username
+	InvalidateUser(twitchName string) error
+
+	// GetCachedGames returns the cached games out of ids, including expired ones
+	GetCachedGames(ids []string) (map[string]*CachedGame, error)
+	// CacheGames caches games along with the ids twitch didn't return
+	CacheGames(games []*GameData, missing []string) error
+}
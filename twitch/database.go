@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/boltdb/bolt"
@@ -24,14 +25,58 @@ type Webhook struct {
 	Token   string `json:"token"`   // webhook token
 }
 
+// Subscription is a discord channel receiving updates for a twitch channel
+type Subscription struct {
+	Channel    string   `json:"channel"` // discord channel id
+	Guild      string   `json:"guild"`   // discord guild id
+	TwitchName string   `json:"twitchname"`
+	Owner      string   `json:"owner"` // name of the bot that owns the subscription
+	Webhook    *Webhook `json:"webhook"`
+}
+
+// ChannelOptions are the per discord channel notification settings
+type ChannelOptions struct {
+	// send a summary of the stream when it ends
+	OfflineSummary bool `json:"offline_summary"`
+}
+
+// SubscriptionOptions are the notification settings of a single subscription
+type SubscriptionOptions struct {
+	// send a message when the title or game of the stream changes
+	StreamUpdates bool `json:"stream_updates"`
+}
+
+// LiveStream is a stream that was live as of the last poll
+type LiveStream struct {
+	Stream  *ChannelData `json:"stream"`
+	Session *Session     `json:"session"`
+	// when the stream went offline, if it's within the grace window
+	OfflineSince time.Time `json:"offline_since"`
+}
+
 var (
-	DB *Database
+	// ErrNotOwner is returned when a bot tries to modify a subscription owned by another bot
+	ErrNotOwner = errors.New("subscription is owned by another bot")
+	// ErrNotFound is returned when a subscription doesn't exist or isn't visible to a bot
+	ErrNotFound = errors.New("subscription not found")
+	// ErrInvalidGuild is returned when a subscription is added with a guild that isn't a discord snowflake
+	ErrInvalidGuild = errors.New("guild must be a discord snowflake")
 )
 
 // NewDB returns a new database
 func NewDB() *Database {
+	d, err := OpenDB("twitch.db")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return d
+}
+
+// OpenDB opens the database at path, creating and migrating it as needed
+func OpenDB(path string) (*Database, error) {
 	boltDB, err := bolt.Open(
-		"twitch.db",
+		path,
 		// read+write
 		0600,
 		&bolt.Options{
@@ -42,132 +87,177 @@ func NewDB() *Database {
 		},
 	)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
-	DB = &Database{
+	d := &Database{
 		db: boltDB,
 	}
-	err = DB.init()
+	err = d.init()
 	if err != nil {
-		log.Fatal(err)
+		boltDB.Close()
+		return nil, err
 	}
 
-	return DB
+	return d, nil
 }
 
 // AddChannel adds a twitch channel to motitor and adds the channelID + webhook to be notified
-func (d *Database) AddChannel(twitchName, channel string, hook *Webhook) (err error) {
-	err = d.db.Update(func(tx *bolt.Tx) error {
-		b := tx.Bucket(bt("twitch-channels"))
-		// add the twitch channel name to the twitch bucket so we know to ask for updates for it
-		return b.Put(bt(twitchName), bt(""))
-	})
-	if err != nil {
-		return
+// the subscription is owned by the given bot, and can't be taken over by another
+// guild is the discord guild the channel is in, and can be empty if it's not known
+func (d *Database) AddChannel(twitchName, channel, guild, owner string, hook *Webhook) error {
+	if guild != "" && !validGuild(guild) {
+		return ErrInvalidGuild
 	}
+	twitchName = normalizeName(twitchName)
+
+	// everything is done in a single transaction so the buckets
+	// can never disagree about what is being tracked
+	return d.db.Update(func(tx *bolt.Tx) error {
+		names, err := channelNames(tx, channel)
+		if err != nil {
+			return err
+		}
+
+		// unowned subscriptions are claimed by the bot that adds them again
+		cur, exists := names[twitchName]
+		if exists && !visibleTo(cur, owner, false) {
+			return ErrNotOwner
+		}
+
+		if guild != "" {
+			// a discord channel never moves between guilds, so it's stored once per channel
+			err = tx.Bucket(bt("discord-guilds")).Put(bt(channel), bt(guild))
+			if err != nil {
+				return err
+			}
+		}
+
+		// add the twitch channel name to the twitch bucket so we know to ask for updates for it
+		// the value is how many discord channels follow it, so only count new subscriptions
+		if !exists {
+			err = d.incrementKey(tx.Bucket(bt("twitch-channels")), bt(twitchName), 1)
+			if err != nil {
+				return err
+			}
+		}
 
-	err = d.db.Update(func(tx *bolt.Tx) error {
 		// get/make the bucket that holds all the discord webhooks receiving updates for a twitch channel
-		b := tx.Bucket(bt("discord-webhooks"))
-		n, err := b.CreateBucketIfNotExists(bt(twitchName))
+		n, err := tx.Bucket(bt("discord-webhooks")).CreateBucketIfNotExists(bt(twitchName))
 		if err != nil {
 			return err
 		}
 
 		// put the webhook data in the bucket
-		return n.Put(bt(channel), bt(hook.ID+":"+hook.Token))
-	})
-	if err != nil {
-		return
-	}
+		err = n.Put(bt(channel), bt(hook.ID+":"+hook.Token))
+		if err != nil {
+			return err
+		}
 
-	var n []byte
-	err = d.db.View(func(tx *bolt.Tx) error {
-		b := tx.Bucket(bt("discord-channels"))
-		n = b.Get(bt(channel))
-		return nil
+		names[twitchName] = owner
+		return putChannelNames(tx, channel, names)
 	})
+}
 
-	raw := []byte{}
-	if n != nil {
-		names := map[string]string{}
-		err = json.Unmarshal(n, &names)
-		if err != nil {
-			return
-		}
+// DeleteWebhook deletes the webhook a discord channel uses to receive updates for a twitch channel
+// if wID isn't empty it must match the id of the stored webhook
+// only the bot owning the subscription can delete it, unless all is set or it's unowned
+func (d *Database) DeleteWebhook(twitchName, wID, cID, owner string, all bool) error {
+	twitchName = normalizeName(twitchName)
 
-		names[twitchName] = ""
-		raw, err = json.Marshal(names)
+	return d.db.Update(func(tx *bolt.Tx) error {
+		names, err := channelNames(tx, cID)
 		if err != nil {
-			return
+			return err
 		}
 
-		err = d.db.Update(func(tx *bolt.Tx) error {
-			b := tx.Bucket(bt("discord-channels"))
-			return b.Put(bt(channel), raw)
-		})
+		cur, ok := names[twitchName]
+		if !ok || !visibleTo(cur, owner, all) {
+			return ErrNotFound
+		}
 
-		return
-	}
+		if wID != "" {
+			hook, err := subscriptionWebhook(tx, twitchName, cID)
+			if err != nil {
+				return err
+			}
+			if hook != nil && hook.ID != wID {
+				return ErrNotFound
+			}
+		}
 
-	raw, err = json.Marshal(map[string]string{
-		twitchName: "",
-	})
-	err = d.db.Update(func(tx *bolt.Tx) error {
-		b := tx.Bucket(bt("discord-channels"))
-		return b.Put(bt(channel), raw)
+		return d.deleteSubscription(tx, twitchName, cID)
 	})
-
-	return
 }
 
-// DeleteWebhook deletes a webhook from a twitch channel
-func (d *Database) DeleteWebhook(twitchName, wID, cID string) (err error) {
-	rawNames := []byte{}
-	err = d.db.Update(func(tx *bolt.Tx) error {
-		// err = d.incrementKey(tx.Bucket(bt("twitch-channels")), bt(twitchName), -1)
-		// if err != nil {
-		// 	return err
-		// }
+// deleteSubscription removes a discord channel's subscription to a twitch channel from every bucket
+// this can only be called within a valid write transaction
+func (d *Database) deleteSubscription(tx *bolt.Tx, twitchName, cID string) error {
+	names, err := channelNames(tx, cID)
+	if err != nil {
+		return err
+	}
 
-		b := tx.Bucket(bt("discord-webhooks"))
-		b = b.Bucket(bt(twitchName))
-		err = b.Delete(bt(wID))
+	if _, ok := names[twitchName]; ok {
+		err = d.incrementKey(tx.Bucket(bt("twitch-channels")), bt(twitchName), -1)
 		if err != nil {
 			return err
 		}
+	}
 
-		b = tx.Bucket(bt("discord-channels"))
-		rawNames = b.Get(bt(cID))
-		return nil
-	})
+	webhooks := tx.Bucket(bt("discord-webhooks"))
+	if b := webhooks.Bucket(bt(twitchName)); b != nil {
+		err = b.Delete(bt(cID))
+		if err != nil {
+			return err
+		}
+
+		if k, _ := b.Cursor().First(); k == nil {
+			err = webhooks.DeleteBucket(bt(twitchName))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = deleteLiveMessage(tx, twitchName, cID)
 	if err != nil {
-		return
+		return err
 	}
 
-	if rawNames != nil {
-		names := map[string]string{}
-		err = json.Unmarshal(rawNames, &names)
+	options := tx.Bucket(bt("subscription-options"))
+	if b := options.Bucket(bt(twitchName)); b != nil {
+		err = b.Delete(bt(cID))
 		if err != nil {
-			return
+			return err
 		}
 
-		delete(names, twitchName)
-		rawNames, err = json.Marshal(names)
-		if err != nil {
-			return
+		if k, _ := b.Cursor().First(); k == nil {
+			err = options.DeleteBucket(bt(twitchName))
+			if err != nil {
+				return err
+			}
 		}
+	}
 
-		err = d.db.Update(func(tx *bolt.Tx) error {
-			b := tx.Bucket(bt("discord-channels"))
-			return b.Put(bt(cID), rawNames)
-		})
+	delete(names, twitchName)
+	if len(names) > 0 {
+		return putChannelNames(tx, cID, names)
 	}
 
-	return
+	// nothing is tracked in the channel anymore
+	err = tx.Bucket(bt("discord-channels")).Delete(bt(cID))
+	if err != nil {
+		return err
+	}
+	err = tx.Bucket(bt("discord-options")).Delete(bt(cID))
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(bt("discord-guilds")).Delete(bt(cID))
 }
 
 // incrementKey increments a key by a given amount
+// the key is deleted once it reaches zero
 // this can only be called within a valid write transaction
 func (d *Database) incrementKey(b *bolt.Bucket, key []byte, amt int) error {
 	toInc := b.Get(key)
@@ -178,27 +268,112 @@ func (d *Database) incrementKey(b *bolt.Bucket, key []byte, amt int) error {
 		return b.Put(key, bt(strconv.Itoa(amt)))
 	}
 
-	cur, err := strconv.Atoi(string(toInc))
-	if err != nil {
-		return err
+	// keys written before counts were tracked are empty
+	// they're treated as zero until RepairChannelCounts is run
+	cur := 0
+	if len(toInc) > 0 {
+		var err error
+		cur, err = strconv.Atoi(string(toInc))
+		if err != nil {
+			return err
+		}
 	}
 
 	cur += amt
-	if cur == 0 {
+	if cur <= 0 {
 		return b.Delete(key)
 	}
 	return b.Put(key, bt(strconv.Itoa(cur)))
 }
 
+// RepairChannelCounts recomputes how many discord channels follow each
+// twitch channel from the discord-webhooks buckets, and stops tracking
+// any twitch channel nobody follows anymore
+// it returns how many twitch channels were changed
+func (d *Database) RepairChannelCounts() (changed int, err error) {
+	err = d.db.Update(func(tx *bolt.Tx) error {
+		changed, err = repairCounts(tx)
+		return err
+	})
+
+	return
+}
+
+// repairCounts is RepairChannelCounts within an existing write transaction
+func repairCounts(tx *bolt.Tx) (changed int, err error) {
+	counts := map[string]int{}
+	webhooks := tx.Bucket(bt("discord-webhooks"))
+	err = webhooks.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return nil
+		}
+
+		return webhooks.Bucket(k).ForEach(func(_, _ []byte) error {
+			counts[string(k)]++
+			return nil
+		})
+	})
+	if err != nil {
+		return
+	}
+
+	twitch := tx.Bucket(bt("twitch-channels"))
+	var stale [][]byte
+	err = twitch.ForEach(func(k, v []byte) error {
+		// skip the nested user and game buckets
+		if v == nil {
+			return nil
+		}
+
+		if counts[string(k)] == 0 {
+			stale = append(stale, k)
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	// keys can't be deleted while iterating
+	for _, e := range stale {
+		err = twitch.Delete(e)
+		if err != nil {
+			return
+		}
+		changed++
+	}
+
+	for name, count := range counts {
+		if count == 0 {
+			continue
+		}
+
+		val := bt(strconv.Itoa(count))
+		if bytes.Equal(twitch.Get(bt(name)), val) {
+			continue
+		}
+
+		err = twitch.Put(bt(name), val)
+		if err != nil {
+			return
+		}
+		changed++
+	}
+
+	return
+}
+
 // GetAllTwitchChannels returns all the twitch channels being tracked
 func (d *Database) GetAllTwitchChannels() (channels []string, err error) {
 	err = d.db.View(func(tx *bolt.Tx) error {
 		b := tx.Bucket(bt("twitch-channels"))
-		b.ForEach(func(k, v []byte) error {
-			channels = append(channels, string(k))
+		return b.ForEach(func(k, v []byte) error {
+			// nested buckets aren't twitch channels
+			if v != nil {
+				channels = append(channels, string(k))
+			}
 			return nil
 		})
-		return nil
 	})
 
 	return
@@ -206,6 +381,7 @@ func (d *Database) GetAllTwitchChannels() (channels []string, err error) {
 
 // GetWebhooksByTwitchName returns a slice of all the webhooks for a twitch channel
 func (d *Database) GetWebhooksByTwitchName(twitchName string) (hooks []*Webhook, err error) {
+	twitchName = normalizeName(twitchName)
 	err = d.db.View(func(tx *bolt.Tx) error {
 		// get bucket containing all the webhooks for a twitch channel
 		b := tx.Bucket(bt("discord-webhooks"))
@@ -214,11 +390,11 @@ func (d *Database) GetWebhooksByTwitchName(twitchName string) (hooks []*Webhook,
 			// to a slice of webhooks to be returned
 			err = h.ForEach(func(k, v []byte) error {
 				// append webhook to slice
-				webhook := bytes.Split(v, bt(":"))
-				if len(webhook) != 2 {
-					return errors.New("incorrect webhook value")
+				hook, err := parseWebhook(string(k), v)
+				if err != nil {
+					return err
 				}
-				hooks = append(hooks, &Webhook{string(k), string(webhook[0]), string(webhook[1])})
+				hooks = append(hooks, hook)
 				return nil
 			})
 		}
@@ -229,112 +405,170 @@ func (d *Database) GetWebhooksByTwitchName(twitchName string) (hooks []*Webhook,
 	return
 }
 
-// GetUserByID returns a twitch user by their id
-// it tries to see if the user is cached and if
-// not calls the twitch api and caches response
-func (d *Database) GetUserByID(id string) (userData *UserData, err error) {
-	var user []byte
+// GetWebhookByChannel returns the webhook a discord channel uses for a subscription visible
+// to the given bot, or nil if there isn't one. all can be set to see every subscription
+func (d *Database) GetWebhookByChannel(cID, owner string, all bool) (hook *Webhook, err error) {
 	err = d.db.View(func(tx *bolt.Tx) error {
-		// guaranteed to exist
-		twitchBucket := tx.Bucket(bt("twitch-channels"))
-		// not guaranteed
-		if userBucket := twitchBucket.Bucket(bt("user-data")); userBucket != nil {
-			copy(user, userBucket.Get(bt(id)))
+		// only the buckets of the twitch channels tracked in the discord channel can have its webhook
+		names, err := channelNames(tx, cID)
+		if err != nil {
+			return err
+		}
+
+		for name, o := range names {
+			if !visibleTo(o, owner, all) {
+				continue
+			}
+
+			hook, err = subscriptionWebhook(tx, name, cID)
+			if err != nil || hook != nil {
+				return err
+			}
 		}
 		return nil
 	})
-	if err != nil {
-		return
-	}
 
-	if user == nil {
-		userData, err = API.GetUserByID(id)
-		if err != nil {
-			return
-		}
+	return
+}
 
-		var raw []byte
-		raw, err = json.Marshal(userData)
-		if err != nil {
-			return
-		}
+// CachedUser is a twitch user from the cache, and when it was cached
+// User is nil when twitch didn't return a user for the id
+type CachedUser struct {
+	User     *UserData
+	CachedAt time.Time
+}
+
+// CachedGame is a twitch game from the cache, and when it was cached
+// Game is nil when twitch didn't return a game for the id
+type CachedGame struct {
+	Game     *GameData
+	CachedAt time.Time
+}
+
+// cacheEntry is how users and games are stored in the cache
+// an entry without data records that twitch doesn't know of the id
+type cacheEntry struct {
+	Data     json.RawMessage `json:"data,omitempty"`
+	CachedAt time.Time       `json:"cached_at"`
+}
 
-		err = d.db.Update(func(tx *bolt.Tx) error {
-			b := tx.Bucket(bt("twitch-channels"))
-			u, err := b.CreateBucketIfNotExists(bt("user-data"))
+// GetCachedUsers returns the cached twitch users out of ids, by id
+// expired entries are returned too, it's up to the caller to check CachedAt
+func (d *Database) GetCachedUsers(ids []string) (users map[string]*CachedUser, err error) {
+	users = map[string]*CachedUser{}
+	err = d.db.View(func(tx *bolt.Tx) error {
+		for _, e := range ids {
+			entry, err := getCached(tx, "user-data", e)
 			if err != nil {
 				return err
 			}
-			return u.Put(bt(id), raw)
-		})
+			if entry == nil {
+				continue
+			}
 
-		return
-	}
+			user := &CachedUser{CachedAt: entry.CachedAt}
+			if entry.Data != nil {
+				err = json.Unmarshal(entry.Data, &user.User)
+				if err != nil {
+					return err
+				}
+			}
+			users[e] = user
+		}
+		return nil
+	})
 
-	err = json.Unmarshal(user, userData)
 	return
 }
 
-// GetGameByID returns a twitch game by it's ID
-// it tries to see if the game is cached and if
-// not calls the twitch api and caches response
-func (d *Database) GetGameByID(id string) (gameData *GameData, err error) {
-	var game []byte
-	err = d.db.View(func(tx *bolt.Tx) error {
-		// guaranteed to exist
-		twitchBucket := tx.Bucket(bt("twitch-channels"))
-		// not guaranteed
-		if gameBucket := twitchBucket.Bucket(bt("game-data")); gameBucket != nil {
-			copy(game, gameBucket.Get(bt(id)))
+// CacheUsers caches twitch users by their id, along with
+// the ids of users twitch didn't return
+func (d *Database) CacheUsers(users []*UserData, missing []string) error {
+	now := time.Now()
+	return d.db.Update(func(tx *bolt.Tx) error {
+		for _, e := range users {
+			err := putCached(tx, "user-data", e.ID, e, now)
+			if err != nil {
+				return err
+			}
+		}
+		for _, e := range missing {
+			err := putCached(tx, "user-data", e, nil, now)
+			if err != nil {
+				return err
+			}
 		}
 		return nil
 	})
-	if err != nil {
-		return
-	}
+}
 
-	if game == nil {
-		gameData, err = API.GetGameByID(id)
-		if err != nil {
-			return
+// InvalidateUser removes a twitch user from the cache by their username
+// so they're requested again the next time they're needed
+func (d *Database) InvalidateUser(twitchName string) error {
+	twitchName = normalizeName(twitchName)
+	return d.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bt("twitch-channels")).Bucket(bt("user-data"))
+		if b == nil {
+			return nil
 		}
 
-		var raw []byte
-		raw, err = json.Marshal(gameData)
-		if err != nil {
-			return
-		}
+		var stale [][]byte
+		err := b.ForEach(func(k, v []byte) error {
+			entry := new(cacheEntry)
+			err := json.Unmarshal(v, entry)
+			if err != nil {
+				return err
+			}
+			if entry.Data == nil {
+				return nil
+			}
 
-		err = d.db.Update(func(tx *bolt.Tx) error {
-			b := tx.Bucket(bt("twitch-channels"))
-			u, err := b.CreateBucketIfNotExists(bt("game-data"))
+			user := new(UserData)
+			err = json.Unmarshal(entry.Data, user)
 			if err != nil {
 				return err
 			}
-			return u.Put(bt(id), raw)
+			if normalizeName(user.Login) == twitchName {
+				stale = append(stale, k)
+			}
+			return nil
 		})
+		if err != nil {
+			return err
+		}
 
-		return
-	}
-
-	err = json.Unmarshal(game, gameData)
-	return
+		for _, e := range stale {
+			err = b.Delete(e)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
-// GetTwitchNamesByChannel return all the tracked twitch names from a discord channel
-func (d *Database) GetTwitchNamesByChannel(cID string) (names []string, err error) {
+// GetCachedGames returns the cached twitch games out of ids, by id
+// expired entries are returned too, it's up to the caller to check CachedAt
+func (d *Database) GetCachedGames(ids []string) (games map[string]*CachedGame, err error) {
+	games = map[string]*CachedGame{}
 	err = d.db.View(func(tx *bolt.Tx) error {
-		b := tx.Bucket(bt("discord-channels"))
-		if c := b.Get(bt(cID)); c != nil {
-			nameMap := map[string]string{}
-			err = json.Unmarshal(c, &nameMap)
+		for _, e := range ids {
+			entry, err := getCached(tx, "game-data", e)
 			if err != nil {
 				return err
 			}
+			if entry == nil {
+				continue
+			}
 
-			for i := range nameMap {
-				names = append(names, i)
+			game := &CachedGame{CachedAt: entry.CachedAt}
+			if entry.Data != nil {
+				err = json.Unmarshal(entry.Data, &game.Game)
+				if err != nil {
+					return err
+				}
 			}
+			games[e] = game
 		}
 		return nil
 	})
@@ -342,34 +576,534 @@ func (d *Database) GetTwitchNamesByChannel(cID string) (names []string, err erro
 	return
 }
 
-func (d *Database) webhook404(hook *Webhook) (err error) {
-	err = d.db.Update(func(tx *bolt.Tx) error {
-		var err error
-		discord := tx.Bucket(bt("discord-channels"))
-		raw := discord.Get(bt(hook.Channel))
-		err = discord.Delete(bt(hook.Channel))
-		if err != nil {
-			return err
+// CacheGames caches twitch games by their id, along with
+// the ids of games twitch didn't return
+func (d *Database) CacheGames(games []*GameData, missing []string) error {
+	now := time.Now()
+	return d.db.Update(func(tx *bolt.Tx) error {
+		for _, e := range games {
+			err := putCached(tx, "game-data", e.ID, e, now)
+			if err != nil {
+				return err
+			}
 		}
-
-		twitchChannels := map[string]string{}
-		err = json.Unmarshal(raw, &twitchChannels)
-		if err != nil {
-			return err
+		for _, e := range missing {
+			err := putCached(tx, "game-data", e, nil, now)
+			if err != nil {
+				return err
+			}
 		}
-
-		// twitch := tx.Bucket(bt("twitch-channels"))
-		// for i := range twitchChannels {
-		// 	err = d.incrementKey(twitch, bt(i), -1)
-		// 	if err != nil {
-		// 		return err
-		// 	}
-		// }
-
 		return nil
 	})
-
-	return
+}
+
+// getCached returns the cache entry for id, or nil if it isn't cached
+func getCached(tx *bolt.Tx, bucket, id string) (*cacheEntry, error) {
+	// guaranteed to exist
+	twitchBucket := tx.Bucket(bt("twitch-channels"))
+	// not guaranteed
+	b := twitchBucket.Bucket(bt(bucket))
+	if b == nil {
+		return nil, nil
+	}
+
+	raw := b.Get(bt(id))
+	if raw == nil {
+		return nil, nil
+	}
+
+	entry := new(cacheEntry)
+	return entry, json.Unmarshal(raw, entry)
+}
+
+// putCached caches v by id, or records that id doesn't exist if v is nil
+// this can only be called within a valid write transaction
+func putCached(tx *bolt.Tx, bucket, id string, v interface{}, at time.Time) error {
+	// streams without a game have an empty game id, there's nothing to cache
+	if id == "" {
+		return nil
+	}
+
+	entry := &cacheEntry{CachedAt: at}
+	if v != nil {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		entry.Data = data
+	}
+
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	b, err := tx.Bucket(bt("twitch-channels")).CreateBucketIfNotExists(bt(bucket))
+	if err != nil {
+		return err
+	}
+
+	return b.Put(bt(id), raw)
+}
+
+// GetTwitchNamesByChannel return all the tracked twitch names from a discord channel
+// that are owned by the given bot or unowned, or every name if all is set
+func (d *Database) GetTwitchNamesByChannel(cID, owner string, all bool) (names []string, err error) {
+	err = d.db.View(func(tx *bolt.Tx) error {
+		nameMap, err := channelNames(tx, cID)
+		if err != nil {
+			return err
+		}
+
+		for i, o := range nameMap {
+			if visibleTo(o, owner, all) {
+				names = append(names, i)
+			}
+		}
+		return nil
+	})
+
+	return
+}
+
+// GetOwnersByTwitchName returns the owner of every subscription to a twitch channel
+// keyed by discord channel id
+func (d *Database) GetOwnersByTwitchName(twitchName string) (owners map[string]string, err error) {
+	twitchName = normalizeName(twitchName)
+	owners = map[string]string{}
+	err = d.db.View(func(tx *bolt.Tx) error {
+		h := tx.Bucket(bt("discord-webhooks")).Bucket(bt(twitchName))
+		if h == nil {
+			return nil
+		}
+
+		return h.ForEach(func(k, v []byte) error {
+			names, err := channelNames(tx, string(k))
+			if err != nil {
+				return err
+			}
+
+			if owner, ok := names[twitchName]; ok {
+				owners[string(k)] = owner
+			}
+			return nil
+		})
+	})
+
+	return
+}
+
+// GetSubscriptionsByShard returns every subscription in a guild handled by a shard
+// that is owned by the given bot or unowned, or every bot's if all is set
+// subscriptions added without a guild, or with one that isn't a snowflake,
+// can't be assigned to a shard and are never returned
+func (d *Database) GetSubscriptionsByShard(shard, shardCount int, owner string, all bool) (subs []*Subscription, err error) {
+	err = d.db.View(func(tx *bolt.Tx) error {
+		guilds := tx.Bucket(bt("discord-guilds"))
+
+		return tx.Bucket(bt("discord-channels")).ForEach(func(k, v []byte) error {
+			guild := guilds.Get(k)
+			if guild == nil {
+				return nil
+			}
+
+			// skip guilds stored before they were validated instead of
+			// failing the shard for every other subscription
+			s, err := guildShard(string(guild), shardCount)
+			if err != nil || s != shard {
+				return nil
+			}
+
+			names := map[string]string{}
+			err = json.Unmarshal(v, &names)
+			if err != nil {
+				return err
+			}
+
+			for name, o := range names {
+				if !visibleTo(o, owner, all) {
+					continue
+				}
+
+				sub := &Subscription{
+					Channel:    string(k),
+					Guild:      string(guild),
+					TwitchName: name,
+					Owner:      o,
+				}
+				sub.Webhook, err = subscriptionWebhook(tx, name, string(k))
+				if err != nil {
+					return err
+				}
+
+				subs = append(subs, sub)
+			}
+			return nil
+		})
+	})
+
+	return
+}
+
+// GetChannelOptions returns the notification settings of a discord channel
+// channels that were never configured get the default settings
+func (d *Database) GetChannelOptions(cID string) (opts *ChannelOptions, err error) {
+	opts = &ChannelOptions{}
+	err = d.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(bt("discord-options")).Get(bt(cID))
+		if raw == nil {
+			return nil
+		}
+
+		return json.Unmarshal(raw, opts)
+	})
+
+	return
+}
+
+// SetChannelOptions changes the notification settings of a discord channel
+// the channel must track a twitch channel owned by owner or unowned, unless all is set
+func (d *Database) SetChannelOptions(cID, owner string, all bool, opts *ChannelOptions) error {
+	return d.db.Update(func(tx *bolt.Tx) error {
+		names, err := channelNames(tx, cID)
+		if err != nil {
+			return err
+		}
+
+		owned := false
+		for _, e := range names {
+			if visibleTo(e, owner, all) {
+				owned = true
+				break
+			}
+		}
+		if !owned {
+			return ErrNotFound
+		}
+
+		raw, err := json.Marshal(opts)
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket(bt("discord-options")).Put(bt(cID), raw)
+	})
+}
+
+// GetSubscriptionOptions returns the notification settings of a discord channel's
+// subscription to a twitch channel, subscriptions that were never configured get the default settings
+func (d *Database) GetSubscriptionOptions(twitchName, cID string) (opts *SubscriptionOptions, err error) {
+	twitchName = normalizeName(twitchName)
+	opts = &SubscriptionOptions{}
+	err = d.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bt("subscription-options")).Bucket(bt(twitchName))
+		if b == nil {
+			return nil
+		}
+
+		raw := b.Get(bt(cID))
+		if raw == nil {
+			return nil
+		}
+
+		return json.Unmarshal(raw, opts)
+	})
+
+	return
+}
+
+// SetSubscriptionOptions changes the notification settings of a discord channel's subscription to a twitch channel
+// the subscription must be owned by owner or unowned, unless all is set
+func (d *Database) SetSubscriptionOptions(twitchName, cID, owner string, all bool, opts *SubscriptionOptions) error {
+	twitchName = normalizeName(twitchName)
+	return d.db.Update(func(tx *bolt.Tx) error {
+		names, err := channelNames(tx, cID)
+		if err != nil {
+			return err
+		}
+
+		cur, ok := names[twitchName]
+		if !ok || !visibleTo(cur, owner, all) {
+			return ErrNotFound
+		}
+
+		raw, err := json.Marshal(opts)
+		if err != nil {
+			return err
+		}
+
+		b, err := tx.Bucket(bt("subscription-options")).CreateBucketIfNotExists(bt(twitchName))
+		if err != nil {
+			return err
+		}
+
+		return b.Put(bt(cID), raw)
+	})
+}
+
+// GetLiveStreams returns the streams that were live as of the last poll, by user id
+func (d *Database) GetLiveStreams() (streams map[string]*LiveStream, err error) {
+	streams = map[string]*LiveStream{}
+	err = d.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bt("live-streams")).ForEach(func(_, v []byte) error {
+			stream := &LiveStream{}
+			err := json.Unmarshal(v, stream)
+			if err != nil {
+				return err
+			}
+
+			if stream.Stream == nil {
+				return nil
+			}
+			if stream.Session == nil {
+				stream.Session = newSession(stream.Stream)
+			}
+
+			// older versions stored streams by stream id
+			streams[stream.Stream.UserID] = stream
+			return nil
+		})
+	})
+
+	return
+}
+
+// SetLiveStreams replaces the stored live streams with streams, by user id
+func (d *Database) SetLiveStreams(streams map[string]*LiveStream) error {
+	return d.db.Update(func(tx *bolt.Tx) error {
+		err := tx.DeleteBucket(bt("live-streams"))
+		if err != nil {
+			return err
+		}
+
+		b, err := tx.CreateBucket(bt("live-streams"))
+		if err != nil {
+			return err
+		}
+
+		for i, e := range streams {
+			raw, err := json.Marshal(e)
+			if err != nil {
+				return err
+			}
+
+			err = b.Put(bt(i), raw)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetLiveMessages returns the ids of the go live messages sent for a
+// twitch channel's current stream, by discord channel id
+func (d *Database) GetLiveMessages(twitchName string) (messages map[string]string, err error) {
+	twitchName = normalizeName(twitchName)
+	messages = map[string]string{}
+	err = d.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bt("live-messages")).Bucket(bt(twitchName))
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			messages[string(k)] = string(v)
+			return nil
+		})
+	})
+
+	return
+}
+
+// SetLiveMessage stores the id of the go live message sent to a discord channel
+func (d *Database) SetLiveMessage(twitchName, cID, messageID string) error {
+	twitchName = normalizeName(twitchName)
+	return d.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.Bucket(bt("live-messages")).CreateBucketIfNotExists(bt(twitchName))
+		if err != nil {
+			return err
+		}
+
+		return b.Put(bt(cID), bt(messageID))
+	})
+}
+
+// DeleteLiveMessage stops tracking a go live message
+// nothing is deleted if a newer message was stored since
+func (d *Database) DeleteLiveMessage(twitchName, cID, messageID string) error {
+	twitchName = normalizeName(twitchName)
+	return d.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bt("live-messages")).Bucket(bt(twitchName))
+		if b == nil || string(b.Get(bt(cID))) != messageID {
+			return nil
+		}
+
+		return deleteLiveMessage(tx, twitchName, cID)
+	})
+}
+
+// deleteLiveMessage removes a discord channel's go live message for a twitch channel
+// this can only be called within a valid write transaction
+func deleteLiveMessage(tx *bolt.Tx, twitchName, cID string) error {
+	messages := tx.Bucket(bt("live-messages"))
+	b := messages.Bucket(bt(twitchName))
+	if b == nil {
+		return nil
+	}
+
+	err := b.Delete(bt(cID))
+	if err != nil {
+		return err
+	}
+
+	if k, _ := b.Cursor().First(); k == nil {
+		return messages.DeleteBucket(bt(twitchName))
+	}
+	return nil
+}
+
+// GetAppToken returns the stored twitch app access token, or nil if there isn't one
+func (d *Database) GetAppToken() (token *AppToken, err error) {
+	err = d.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(bt("meta")).Get(bt("app-token"))
+		if raw == nil {
+			return nil
+		}
+
+		return json.Unmarshal(raw, &token)
+	})
+
+	return
+}
+
+// SetAppToken stores the twitch app access token, or deletes it if token is nil
+func (d *Database) SetAppToken(token *AppToken) error {
+	return d.db.Update(func(tx *bolt.Tx) error {
+		meta := tx.Bucket(bt("meta"))
+		if token == nil {
+			return meta.Delete(bt("app-token"))
+		}
+
+		raw, err := json.Marshal(token)
+		if err != nil {
+			return err
+		}
+
+		return meta.Put(bt("app-token"), raw)
+	})
+}
+
+// validGuild reports whether a guild is a discord snowflake
+func validGuild(guild string) bool {
+	_, err := strconv.ParseUint(guild, 10, 64)
+	return err == nil
+}
+
+// guildShard returns the shard a guild is handled by
+// https://discordapp.com/developers/docs/topics/gateway#sharding
+func guildShard(guild string, shardCount int) (int, error) {
+	id, err := strconv.ParseUint(guild, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return int((id >> 22) % uint64(shardCount)), nil
+}
+
+// subscriptionWebhook returns the webhook a discord channel uses for a twitch channel, or nil if there isn't one
+func subscriptionWebhook(tx *bolt.Tx, twitchName, cID string) (*Webhook, error) {
+	b := tx.Bucket(bt("discord-webhooks")).Bucket(bt(twitchName))
+	if b == nil {
+		return nil, nil
+	}
+
+	raw := b.Get(bt(cID))
+	if raw == nil {
+		return nil, nil
+	}
+
+	return parseWebhook(cID, raw)
+}
+
+// parseWebhook parses a webhook stored as "id:token"
+func parseWebhook(cID string, raw []byte) (*Webhook, error) {
+	webhook := bytes.Split(raw, bt(":"))
+	if len(webhook) != 2 {
+		return nil, errors.New("incorrect webhook value")
+	}
+
+	return &Webhook{cID, string(webhook[0]), string(webhook[1])}, nil
+}
+
+// visibleTo reports whether a bot can see and change a subscription owned by cur
+// all is set for admins who can see every subscription, and subscriptions
+// added before they had owners are unowned so any bot can see and claim them
+func visibleTo(cur, owner string, all bool) bool {
+	return all || cur == "" || cur == owner
+}
+
+// normalizeName returns the form twitch names are stored in
+// twitch usernames are case insensitive, so they're always stored lowercase
+func normalizeName(twitchName string) string {
+	return strings.ToLower(twitchName)
+}
+
+// channelNames returns the twitch names tracked in a discord channel mapped to their owner
+// the map is never nil
+func channelNames(tx *bolt.Tx, cID string) (map[string]string, error) {
+	names := map[string]string{}
+
+	raw := tx.Bucket(bt("discord-channels")).Get(bt(cID))
+	if raw == nil {
+		return names, nil
+	}
+
+	err := json.Unmarshal(raw, &names)
+	return names, err
+}
+
+// putChannelNames stores the twitch names tracked in a discord channel
+// this can only be called within a valid write transaction
+func putChannelNames(tx *bolt.Tx, cID string, names map[string]string) error {
+	raw, err := json.Marshal(names)
+	if err != nil {
+		return err
+	}
+
+	return tx.Bucket(bt("discord-channels")).Put(bt(cID), raw)
+}
+
+// RemoveWebhook removes every subscription using a webhook that no longer exists
+func (d *Database) RemoveWebhook(hook *Webhook) (err error) {
+	err = d.db.Update(func(tx *bolt.Tx) error {
+		twitchChannels, err := channelNames(tx, hook.Channel)
+		if err != nil {
+			return err
+		}
+
+		for i := range twitchChannels {
+			cur, err := subscriptionWebhook(tx, i, hook.Channel)
+			if err != nil {
+				return err
+			}
+
+			// the channel may have been given a new webhook since this one was sent to
+			if cur != nil && cur.ID != hook.ID {
+				continue
+			}
+
+			err = d.deleteSubscription(tx, i, hook.Channel)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return
 }
 
 // Close closes the current databse
@@ -392,8 +1126,32 @@ func (d *Database) init() error {
 		if err != nil {
 			return fmt.Errorf("create bucket: %s", err)
 		}
+		_, err = tx.CreateBucketIfNotExists(bt("discord-guilds"))
+		if err != nil {
+			return fmt.Errorf("create bucket: %s", err)
+		}
+		_, err = tx.CreateBucketIfNotExists(bt("discord-options"))
+		if err != nil {
+			return fmt.Errorf("create bucket: %s", err)
+		}
+		_, err = tx.CreateBucketIfNotExists(bt("subscription-options"))
+		if err != nil {
+			return fmt.Errorf("create bucket: %s", err)
+		}
+		_, err = tx.CreateBucketIfNotExists(bt("live-streams"))
+		if err != nil {
+			return fmt.Errorf("create bucket: %s", err)
+		}
+		_, err = tx.CreateBucketIfNotExists(bt("live-messages"))
+		if err != nil {
+			return fmt.Errorf("create bucket: %s", err)
+		}
+		_, err = tx.CreateBucketIfNotExists(bt("meta"))
+		if err != nil {
+			return fmt.Errorf("create bucket: %s", err)
+		}
 
-		return nil
+		return migrate(tx)
 	})
 }
 
@@ -0,0 +1,297 @@
+package twitch
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// subscriptionBuckets are the buckets that have to agree about what is being tracked
+var subscriptionBuckets = []string{
+	"twitch-channels",
+	"discord-webhooks",
+	"discord-channels",
+	"discord-guilds",
+	"discord-options",
+	"subscription-options",
+	"live-messages",
+}
+
+func openTestDB(t *testing.T) *Database {
+	d, err := OpenDB(filepath.Join(t.TempDir(), "twitch.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(d.Close)
+
+	return d
+}
+
+// dumpBuckets returns every key in the subscription buckets by its path
+// nested buckets are recorded as a key without a value
+func dumpBuckets(t *testing.T, d *Database) map[string]string {
+	dump := map[string]string{}
+
+	var walk func(path string, b *bolt.Bucket) error
+	walk = func(path string, b *bolt.Bucket) error {
+		return b.ForEach(func(k, v []byte) error {
+			key := path + "/" + string(k)
+			if v != nil {
+				dump[key] = string(v)
+				return nil
+			}
+
+			dump[key+"/"] = ""
+			return walk(key, b.Bucket(k))
+		})
+	}
+
+	err := d.db.View(func(tx *bolt.Tx) error {
+		for _, e := range subscriptionBuckets {
+			err := walk(e, tx.Bucket(bt(e)))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return dump
+}
+
+const testGuild = "173184118492889089"
+
+// newSubscriptions returns a database where channel 1 follows streamer and other,
+// and channel 2 follows streamer with a different webhook
+func newSubscriptions(t *testing.T) *Database {
+	d := openTestDB(t)
+
+	for _, e := range []struct {
+		twitchName, channel, guild string
+		hook                       *Webhook
+	}{
+		{"streamer", "1", testGuild, &Webhook{ID: "hook1", Token: "token1"}},
+		{"other", "1", testGuild, &Webhook{ID: "hook1", Token: "token1"}},
+		{"streamer", "2", "", &Webhook{ID: "hook2", Token: "token2"}},
+	} {
+		err := d.AddChannel(e.twitchName, e.channel, e.guild, "bot", e.hook)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := d.SetLiveMessage("streamer", "1", "message")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.SetSubscriptionOptions("streamer", "1", "bot", false, &SubscriptionOptions{StreamUpdates: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return d
+}
+
+func TestTransactionRollback(t *testing.T) {
+	for _, e := range []struct {
+		name string
+		// breaks the database so the call fails partway through its transaction
+		inject func(tx *bolt.Tx) error
+		call   func(d *Database) error
+	}{
+		{
+			name: "add after the guild is stored",
+			inject: func(tx *bolt.Tx) error {
+				return tx.Bucket(bt("twitch-channels")).Put(bt("streamer"), bt("unparsable"))
+			},
+			call: func(d *Database) error {
+				return d.AddChannel("streamer", "3", testGuild, "bot", &Webhook{ID: "hook3", Token: "token3"})
+			},
+		},
+		{
+			name: "add after the count is incremented",
+			inject: func(tx *bolt.Tx) error {
+				return tx.Bucket(bt("discord-webhooks")).Put(bt("new"), bt("not a bucket"))
+			},
+			call: func(d *Database) error {
+				return d.AddChannel("new", "1", testGuild, "bot", &Webhook{ID: "hook1", Token: "token1"})
+			},
+		},
+		{
+			name: "delete with an unparsable webhook",
+			inject: func(tx *bolt.Tx) error {
+				return tx.Bucket(bt("discord-webhooks")).Bucket(bt("streamer")).Put(bt("1"), bt("unparsable"))
+			},
+			call: func(d *Database) error {
+				return d.DeleteWebhook("streamer", "hook1", "1", "bot", false)
+			},
+		},
+		{
+			name: "delete after the count and webhook are removed",
+			inject: func(tx *bolt.Tx) error {
+				_, err := tx.Bucket(bt("live-messages")).Bucket(bt("streamer")).CreateBucket(bt("2"))
+				return err
+			},
+			call: func(d *Database) error {
+				return d.DeleteWebhook("streamer", "", "2", "bot", false)
+			},
+		},
+		{
+			name: "delete after the channel is removed",
+			inject: func(tx *bolt.Tx) error {
+				_, err := tx.Bucket(bt("discord-options")).CreateBucket(bt("2"))
+				return err
+			},
+			call: func(d *Database) error {
+				return d.DeleteWebhook("streamer", "", "2", "bot", false)
+			},
+		},
+		{
+			name: "remove with an unparsable webhook",
+			inject: func(tx *bolt.Tx) error {
+				return tx.Bucket(bt("discord-webhooks")).Bucket(bt("other")).Put(bt("1"), bt("unparsable"))
+			},
+			call: func(d *Database) error {
+				return d.RemoveWebhook(&Webhook{Channel: "1", ID: "hook1", Token: "token1"})
+			},
+		},
+		{
+			name: "remove after every subscription is deleted",
+			inject: func(tx *bolt.Tx) error {
+				_, err := tx.Bucket(bt("discord-options")).CreateBucket(bt("1"))
+				return err
+			},
+			call: func(d *Database) error {
+				return d.RemoveWebhook(&Webhook{Channel: "1", ID: "hook1", Token: "token1"})
+			},
+		},
+	} {
+		t.Run(e.name, func(t *testing.T) {
+			d := newSubscriptions(t)
+			err := d.db.Update(e.inject)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			before := dumpBuckets(t, d)
+			if err := e.call(d); err == nil {
+				t.Fatal("expected the injected failure to be returned")
+			}
+
+			after := dumpBuckets(t, d)
+			if !reflect.DeepEqual(before, after) {
+				t.Fatalf("buckets changed after a failed transaction\nbefore: %v\nafter:  %v", before, after)
+			}
+		})
+	}
+}
+
+func TestSubscriptionRoundTrip(t *testing.T) {
+	seed := time.Now().UnixNano()
+	t.Logf("seed %d", seed)
+	r := rand.New(rand.NewSource(seed))
+
+	d := openTestDB(t)
+	// the webhook id of every subscription, by discord channel and twitch name
+	model := map[string]map[string]string{}
+	names := []string{"streamer", "Streamer", "STREAMER", "other", "third", "fourth"}
+
+	for i := 0; i < 500; i++ {
+		channel := strconv.Itoa(r.Intn(5))
+		name := names[r.Intn(len(names))]
+
+		switch r.Intn(3) {
+		case 0:
+			hook := &Webhook{ID: fmt.Sprintf("hook%s-%d", channel, r.Intn(2)), Token: "token"}
+			err := d.AddChannel(name, channel, testGuild, "bot", hook)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if model[channel] == nil {
+				model[channel] = map[string]string{}
+			}
+			model[channel][normalizeName(name)] = hook.ID
+
+		case 1:
+			err := d.DeleteWebhook(name, "", channel, "bot", false)
+			_, tracked := model[channel][normalizeName(name)]
+			if !tracked && err != ErrNotFound {
+				t.Fatalf("expected deleting an untracked subscription to not be found, got %v", err)
+			}
+			if tracked && err != nil {
+				t.Fatal(err)
+			}
+
+			delete(model[channel], normalizeName(name))
+
+		case 2:
+			id := fmt.Sprintf("hook%s-%d", channel, r.Intn(2))
+			err := d.RemoveWebhook(&Webhook{Channel: channel, ID: id, Token: "token"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for name, hook := range model[channel] {
+				if hook == id {
+					delete(model[channel], name)
+				}
+			}
+		}
+
+		if len(model[channel]) == 0 {
+			delete(model, channel)
+		}
+		checkSubscriptions(t, d, model)
+	}
+}
+
+// checkSubscriptions fails the test if the buckets don't hold exactly the subscriptions
+// in model, or if any twitch channel's count doesn't match how many channels follow it
+func checkSubscriptions(t *testing.T, d *Database, model map[string]map[string]string) {
+	t.Helper()
+	expected := map[string]string{}
+	counts := map[string]int{}
+	for channel, names := range model {
+		owners := map[string]string{}
+		for name, hook := range names {
+			owners[name] = "bot"
+			counts[name]++
+			expected["discord-webhooks/"+name+"/"] = ""
+			expected["discord-webhooks/"+name+"/"+channel] = hook + ":token"
+		}
+
+		raw, err := json.Marshal(owners)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected["discord-channels/"+channel] = string(raw)
+		expected["discord-guilds/"+channel] = testGuild
+	}
+	for name, count := range counts {
+		expected["twitch-channels/"+name] = strconv.Itoa(count)
+	}
+
+	actual := dumpBuckets(t, d)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("buckets don't match the subscriptions\nexpected: %v\nactual:   %v", expected, actual)
+	}
+
+	changed, err := d.RepairChannelCounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed != 0 {
+		t.Fatalf("expected the counts to already be correct, %d were repaired", changed)
+	}
+}
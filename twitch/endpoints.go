@@ -2,10 +2,19 @@ package twitch
 
 import "strings"
 
+// DefaultHelixURL is the base url of twitch's helix api
+const DefaultHelixURL = "https://api.twitch.tv/helix"
+
 var (
-	webhookEndpoint  = func(id, token string) string { return "https://discordapp.com/api/v6/webhooks/" + id + "/" + token }
-	channelsEndpoint = func(channels []string) string {
-		return "https://api.twitch.tv/helix/streams?user_login=" + strings.Join(channels, "&user_login=")
+	webhookEndpoint        = func(id, token string) string { return "https://discordapp.com/api/v6/webhooks/" + id + "/" + token }
+	webhookMessageEndpoint = func(id, token, message string) string { return webhookEndpoint(id, token) + "/messages/" + message }
+	channelsEndpoint       = func(helix string, channels []string) string {
+		return helix + "/streams?user_login=" + strings.Join(channels, "&user_login=")
+	}
+	usersEndpoint = func(helix string, ids []string) string {
+		return helix + "/users?id=" + strings.Join(ids, "&id=")
+	}
+	gamesEndpoint = func(helix string, ids []string) string {
+		return helix + "/games?id=" + strings.Join(ids, "&id=")
 	}
-	userEndpoint = func(id string) string { return "https://api.twitch.tv/helix/users?id=" + id }
 )
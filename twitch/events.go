@@ -0,0 +1,182 @@
+package twitch
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+// EventType is the kind of change that happened to a stream
+type EventType int
+
+// The types of events sent to subscribers
+const (
+	EventOnline EventType = iota
+	EventOffline
+	EventUpdated
+)
+
+// Event is a change in the state of a tracked stream
+type Event struct {
+	Type EventType
+	// twitch username
+	Login string
+	// discord channels tracking the stream
+	Channels []string
+	Stream   *ChannelData
+	// only set for EventUpdated
+	Previous *ChannelData
+
+	// owner of the subscription in each discord channel
+	owners map[string]string
+}
+
+// ErrSlowConsumer is returned by a watcher that was closed
+// because it fell too far behind on receiving events
+var ErrSlowConsumer = errors.New("subscriber is not keeping up with events")
+
+// watcherBuffer is the amount of events a watcher can have
+// waiting before it is considered too slow and is closed
+const watcherBuffer = 64
+
+// Events fans out stream events to subscribers
+// publishing never blocks, subscribers that can't keep up are dropped
+type Events struct {
+	mu       sync.Mutex
+	watchers map[*Watcher]struct{}
+}
+
+// Watcher receives the events matching its filter
+type Watcher struct {
+	events   chan *Event
+	owner    string
+	all      bool
+	channels map[string]bool
+	logins   map[string]bool
+	err      error
+}
+
+// NewEvents ...
+func NewEvents() *Events {
+	return &Events{
+		watchers: map[*Watcher]struct{}{},
+	}
+}
+
+// Subscribe returns a watcher for events happening to any of the
+// twitch usernames or to streams tracked in any of the discord channels
+// only discord channels subscribed by owner or unowned are visible, unless all is set
+func (e *Events) Subscribe(owner string, all bool, channels, logins []string) *Watcher {
+	// twitch usernames are case insensitive
+	lower := make([]string, len(logins))
+	for i, l := range logins {
+		lower[i] = strings.ToLower(l)
+	}
+
+	w := &Watcher{
+		events:   make(chan *Event, watcherBuffer),
+		owner:    owner,
+		all:      all,
+		channels: toSet(channels),
+		logins:   toSet(lower),
+	}
+
+	e.mu.Lock()
+	e.watchers[w] = struct{}{}
+	e.mu.Unlock()
+
+	return w
+}
+
+// Unsubscribe stops a watcher from receiving events
+func (e *Events) Unsubscribe(w *Watcher) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if _, ok := e.watchers[w]; ok {
+		delete(e.watchers, w)
+		close(w.events)
+	}
+}
+
+// Publish sends an event to every matching watcher
+func (e *Events) Publish(event *Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for w := range e.watchers {
+		scoped := w.scope(event)
+		if !w.matches(scoped) {
+			continue
+		}
+
+		select {
+		case w.events <- scoped:
+		default:
+			// the poller can't wait on anyone, so a subscriber with a
+			// full buffer is closed rather than silently missing events
+			w.err = ErrSlowConsumer
+			delete(e.watchers, w)
+			close(w.events)
+		}
+	}
+}
+
+// Watched reports whether there are any watchers
+func (e *Events) Watched() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return len(e.watchers) > 0
+}
+
+// Events returns the channel events are delivered on
+// it is closed when the watcher ends
+func (w *Watcher) Events() <-chan *Event {
+	return w.events
+}
+
+// Err returns why the watcher was closed
+// it is only valid after the events channel is closed
+func (w *Watcher) Err() error {
+	return w.err
+}
+
+// scope returns a copy of event with only the discord channels visible to the watcher
+func (w *Watcher) scope(event *Event) *Event {
+	if w.all {
+		return event
+	}
+
+	scoped := *event
+	scoped.Channels = nil
+	for _, c := range event.Channels {
+		if visibleTo(event.owners[c], w.owner, false) {
+			scoped.Channels = append(scoped.Channels, c)
+		}
+	}
+
+	return &scoped
+}
+
+func (w *Watcher) matches(event *Event) bool {
+	if w.logins[event.Login] {
+		return true
+	}
+
+	for _, c := range event.Channels {
+		if w.channels[c] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toSet(s []string) map[string]bool {
+	set := make(map[string]bool, len(s))
+	for _, e := range s {
+		set[e] = true
+	}
+	return set
+}
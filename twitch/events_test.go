@@ -0,0 +1,128 @@
+package twitch
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// receive returns the next event delivered to w, or nil if there isn't one waiting
+func receive(w *Watcher) *Event {
+	select {
+	case e := <-w.Events():
+		return e
+	default:
+		return nil
+	}
+}
+
+func TestEventsFilter(t *testing.T) {
+	events := NewEvents()
+	byChannel := events.Subscribe("bot", false, []string{"1"}, nil)
+	byLogin := events.Subscribe("bot", false, nil, []string{"Streamer"})
+
+	events.Publish(&Event{Type: EventOnline, Login: "streamer", Channels: []string{"2"}})
+	if e := receive(byLogin); e == nil || e.Login != "streamer" {
+		t.Fatalf("expected the login watcher to get the event regardless of case, got %v", e)
+	}
+	if e := receive(byChannel); e != nil {
+		t.Fatalf("expected the channel watcher to not get an event for another channel, got %v", e)
+	}
+
+	events.Publish(&Event{Type: EventOffline, Login: "other", Channels: []string{"1", "2"}})
+	if e := receive(byChannel); e == nil || e.Type != EventOffline {
+		t.Fatalf("expected the channel watcher to get the event, got %v", e)
+	}
+	if e := receive(byLogin); e != nil {
+		t.Fatalf("expected the login watcher to not get an event for another login, got %v", e)
+	}
+}
+
+func TestEventsOwners(t *testing.T) {
+	events := NewEvents()
+	bot := events.Subscribe("bot", false, []string{"1", "2", "3"}, nil)
+	other := events.Subscribe("other", false, []string{"1", "2", "3"}, nil)
+	admin := events.Subscribe("admin", true, []string{"1"}, nil)
+	byLogin := events.Subscribe("other", false, nil, []string{"streamer"})
+
+	events.Publish(&Event{
+		Type:     EventOnline,
+		Login:    "streamer",
+		Channels: []string{"1", "2", "3"},
+		// channel 3 was subscribed before subscriptions had owners
+		owners: map[string]string{"1": "bot", "2": "bot", "3": ""},
+	})
+
+	for _, e := range []struct {
+		name     string
+		w        *Watcher
+		channels []string
+	}{
+		{"bot", bot, []string{"1", "2", "3"}},
+		{"other", other, []string{"3"}},
+		{"admin", admin, []string{"1", "2", "3"}},
+		{"other by login", byLogin, []string{"3"}},
+	} {
+		event := receive(e.w)
+		if event == nil {
+			t.Fatalf("expected %s to get the event", e.name)
+		}
+		if !reflect.DeepEqual(event.Channels, e.channels) {
+			t.Fatalf("expected %s to see channels %v, got %v", e.name, e.channels, event.Channels)
+		}
+	}
+
+	// nothing in channel 1 is visible to other, so it isn't told about the event at all
+	events.Publish(&Event{Type: EventOffline, Login: "streamer", Channels: []string{"1"}, owners: map[string]string{"1": "bot"}})
+	if e := receive(other); e != nil {
+		t.Fatalf("expected other to not get an event for another bot's subscription, got %v", e)
+	}
+	if e := receive(bot); e == nil {
+		t.Fatal("expected bot to get the event for its own subscription")
+	}
+}
+
+func TestEventsSlowConsumer(t *testing.T) {
+	events := NewEvents()
+	slow := events.Subscribe("bot", false, nil, []string{"streamer"})
+	fast := events.Subscribe("bot", false, nil, []string{"streamer"})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < watcherBuffer+1; i++ {
+			events.Publish(&Event{Type: EventUpdated, Login: "streamer"})
+			// fast keeps up, so it's never dropped
+			if receive(fast) == nil {
+				t.Error("expected fast to get every event")
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("publishing blocked on a watcher that isn't receiving")
+	}
+
+	// the buffered events are still delivered before the watcher is closed
+	for i := 0; i < watcherBuffer; i++ {
+		if _, ok := <-slow.Events(); !ok {
+			t.Fatalf("expected %d buffered events, the watcher was closed after %d", watcherBuffer, i)
+		}
+	}
+	if _, ok := <-slow.Events(); ok {
+		t.Fatal("expected the watcher to be closed once its buffer overflowed")
+	}
+	if slow.Err() != ErrSlowConsumer {
+		t.Fatalf("expected %v, got %v", ErrSlowConsumer, slow.Err())
+	}
+
+	// unsubscribing a dropped watcher doesn't close it again
+	events.Unsubscribe(slow)
+	events.Unsubscribe(fast)
+	if events.Watched() {
+		t.Fatal("expected no watchers to be left")
+	}
+}
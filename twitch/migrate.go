@@ -0,0 +1,257 @@
+package twitch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/boltdb/bolt"
+)
+
+// schemaVersion is the version of the bucket layout described in db.txt
+// bump it and add a step to migrations whenever the layout changes
+const schemaVersion = 3
+
+// migrations bring the database from the version before their index + 1
+var migrations = []func(tx *bolt.Tx) error{
+	migrateSubscriptionKeys,
+	migrateCacheEntries,
+	migrateStreamUpdates,
+}
+
+// migrate runs every migration newer than the stored schema version
+// this can only be called within a valid write transaction
+func migrate(tx *bolt.Tx) error {
+	meta := tx.Bucket(bt("meta"))
+
+	version := 0
+	if raw := meta.Get(bt("schema-version")); raw != nil {
+		var err error
+		version, err = strconv.Atoi(string(raw))
+		if err != nil {
+			return fmt.Errorf("read schema version: %s", err)
+		}
+	}
+
+	if version > schemaVersion {
+		return fmt.Errorf("database schema version %d is newer than %d", version, schemaVersion)
+	}
+
+	for ; version < schemaVersion; version++ {
+		err := migrations[version](tx)
+		if err != nil {
+			return fmt.Errorf("migrate to schema version %d: %s", version+1, err)
+		}
+	}
+
+	return meta.Put(bt("schema-version"), bt(strconv.Itoa(version)))
+}
+
+// migrateSubscriptionKeys moves existing data to the canonical subscription model
+// twitch names are lowercased, and subscriptions left half deleted by the
+// old DeleteWebhook and webhook404 are dropped from every bucket
+func migrateSubscriptionKeys(tx *bolt.Tx) error {
+	webhooks := tx.Bucket(bt("discord-webhooks"))
+
+	// merge each twitch name's webhooks into its lowercased bucket
+	var mixed []string
+	err := webhooks.ForEach(func(k, v []byte) error {
+		if v == nil && normalizeName(string(k)) != string(k) {
+			mixed = append(mixed, string(k))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, e := range mixed {
+		dst, err := webhooks.CreateBucketIfNotExists(bt(normalizeName(e)))
+		if err != nil {
+			return err
+		}
+
+		err = webhooks.Bucket(bt(e)).ForEach(func(k, v []byte) error {
+			if dst.Get(k) != nil {
+				return nil
+			}
+			return dst.Put(k, v)
+		})
+		if err != nil {
+			return err
+		}
+
+		err = webhooks.DeleteBucket(bt(e))
+		if err != nil {
+			return err
+		}
+	}
+
+	// lowercase the twitch names tracked in each discord channel
+	channels := tx.Bucket(bt("discord-channels"))
+	tracked := map[string]map[string]string{}
+	err = channels.ForEach(func(k, v []byte) error {
+		names := map[string]string{}
+		if len(v) > 0 {
+			err := json.Unmarshal(v, &names)
+			if err != nil {
+				return err
+			}
+		}
+
+		lower := make(map[string]string, len(names))
+		for name, owner := range names {
+			if cur, ok := lower[normalizeName(name)]; ok && cur != "" {
+				continue
+			}
+			lower[normalizeName(name)] = owner
+		}
+
+		tracked[string(k)] = lower
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// drop webhooks whose discord channel no longer tracks the twitch name
+	var logins []string
+	err = webhooks.ForEach(func(k, v []byte) error {
+		if v == nil {
+			logins = append(logins, string(k))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, login := range logins {
+		b := webhooks.Bucket(bt(login))
+
+		var orphaned [][]byte
+		err = b.ForEach(func(k, _ []byte) error {
+			if _, ok := tracked[string(k)][login]; !ok {
+				orphaned = append(orphaned, k)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, e := range orphaned {
+			err = b.Delete(e)
+			if err != nil {
+				return err
+			}
+		}
+
+		if k, _ := b.Cursor().First(); k == nil {
+			err = webhooks.DeleteBucket(bt(login))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// drop twitch names that no webhook is stored for
+	for cID, names := range tracked {
+		for name := range names {
+			b := webhooks.Bucket(bt(name))
+			if b == nil || b.Get(bt(cID)) == nil {
+				delete(names, name)
+			}
+		}
+
+		if len(names) > 0 {
+			err = putChannelNames(tx, cID, names)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = channels.Delete(bt(cID))
+		if err != nil {
+			return err
+		}
+		err = tx.Bucket(bt("discord-guilds")).Delete(bt(cID))
+		if err != nil {
+			return err
+		}
+	}
+
+	// the counts are derived from the webhooks, so they're rebuilt last
+	_, err = repairCounts(tx)
+	return err
+}
+
+// migrateCacheEntries empties the user and game caches, their entries
+// were stored without when they were cached so they can't expire
+func migrateCacheEntries(tx *bolt.Tx) error {
+	b := tx.Bucket(bt("twitch-channels"))
+	for _, e := range []string{"user-data", "game-data"} {
+		if b.Bucket(bt(e)) == nil {
+			continue
+		}
+
+		err := b.DeleteBucket(bt(e))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateStreamUpdates moves stream updates from the discord channel's
+// options to the options of every subscription in the channel
+func migrateStreamUpdates(tx *bolt.Tx) error {
+	options := tx.Bucket(bt("discord-options"))
+
+	var enabled []string
+	err := options.ForEach(func(k, v []byte) error {
+		var opts struct {
+			StreamUpdates bool `json:"stream_updates"`
+		}
+		err := json.Unmarshal(v, &opts)
+		if err != nil {
+			return err
+		}
+
+		if opts.StreamUpdates {
+			enabled = append(enabled, string(k))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(&SubscriptionOptions{StreamUpdates: true})
+	if err != nil {
+		return err
+	}
+
+	for _, cID := range enabled {
+		names, err := channelNames(tx, cID)
+		if err != nil {
+			return err
+		}
+
+		for name := range names {
+			b, err := tx.Bucket(bt("subscription-options")).CreateBucketIfNotExists(bt(name))
+			if err != nil {
+				return err
+			}
+
+			err = b.Put(bt(cID), raw)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
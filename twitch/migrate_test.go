@@ -0,0 +1,130 @@
+package twitch
+
+import (
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/boltdb/bolt"
+)
+
+func TestMigrate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "twitch.db")
+
+	// seed the layout left behind by versions without a schema version
+	old, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = old.Update(func(tx *bolt.Tx) error {
+		put := func(bucket, key, val string) {
+			if err != nil {
+				return
+			}
+			var b *bolt.Bucket
+			b, err = tx.CreateBucketIfNotExists(bt(bucket))
+			if err != nil {
+				return
+			}
+			err = b.Put(bt(key), bt(val))
+		}
+		nested := func(bucket, name, key, val string) {
+			if err != nil {
+				return
+			}
+			var b *bolt.Bucket
+			b, err = tx.CreateBucketIfNotExists(bt(bucket))
+			if err != nil {
+				return
+			}
+			b, err = b.CreateBucketIfNotExists(bt(name))
+			if err != nil {
+				return
+			}
+			err = b.Put(bt(key), bt(val))
+		}
+
+		// counts written before they were tracked are empty
+		put("twitch-channels", "Streamer", "")
+		put("twitch-channels", "other", "")
+		put("twitch-channels", "orphan", "3")
+		// cache entries without when they were cached
+		nested("twitch-channels", "user-data", "1", `{"id":"1"}`)
+
+		// the same twitch channel under two cases
+		nested("discord-webhooks", "Streamer", "1", "hook1:token1")
+		nested("discord-webhooks", "streamer", "2", "hook2:token2")
+		nested("discord-webhooks", "other", "1", "hook1:token1")
+		// channel 3 no longer tracks orphan
+		nested("discord-webhooks", "orphan", "3", "hook3:token3")
+
+		put("discord-channels", "1", `{"Streamer": "", "other": "bot", "missing": ""}`)
+		put("discord-channels", "2", `{"STREAMER": ""}`)
+		// nothing tracked in channel 3 has a webhook
+		put("discord-channels", "3", `{"missing": ""}`)
+
+		put("discord-guilds", "1", testGuild)
+		put("discord-guilds", "3", testGuild)
+		// stream updates used to be enabled for the whole channel
+		put("discord-options", "1", `{"offline_summary":true,"stream_updates":true}`)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	old.Close()
+
+	d, err := OpenDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	expected := map[string]string{
+		"twitch-channels/streamer":        "2",
+		"twitch-channels/other":           "1",
+		"discord-webhooks/streamer/":      "",
+		"discord-webhooks/streamer/1":     "hook1:token1",
+		"discord-webhooks/streamer/2":     "hook2:token2",
+		"discord-webhooks/other/":         "",
+		"discord-webhooks/other/1":        "hook1:token1",
+		"discord-channels/1":              `{"other":"bot","streamer":""}`,
+		"discord-channels/2":              `{"streamer":""}`,
+		"discord-guilds/1":                testGuild,
+		"discord-options/1":               `{"offline_summary":true,"stream_updates":true}`,
+		"subscription-options/streamer/":  "",
+		"subscription-options/streamer/1": `{"stream_updates":true}`,
+		"subscription-options/other/":     "",
+		"subscription-options/other/1":    `{"stream_updates":true}`,
+	}
+	actual := dumpBuckets(t, d)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("unexpected buckets after migrating\nexpected: %v\nactual:   %v", expected, actual)
+	}
+
+	var version string
+	err = d.db.View(func(tx *bolt.Tx) error {
+		version = string(tx.Bucket(bt("meta")).Get(bt("schema-version")))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != strconv.Itoa(schemaVersion) {
+		t.Fatalf("expected schema version %d, got %q", schemaVersion, version)
+	}
+
+	// unowned subscriptions are claimed by the next bot to add them
+	err = d.AddChannel("streamer", "1", "", "bot", &Webhook{ID: "hook1", Token: "token1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := d.GetTwitchNamesByChannel("1", "another bot", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 0 {
+		t.Fatalf("expected claimed subscriptions to be hidden from another bot, got %v", names)
+	}
+}
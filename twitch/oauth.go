@@ -0,0 +1,190 @@
+package twitch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultTokenURL is twitch's oauth token endpoint
+const DefaultTokenURL = "https://id.twitch.tv/oauth2/token"
+
+const (
+	// tokenRefreshWindow is how long before it expires an app token is replaced
+	tokenRefreshWindow = time.Hour
+	// tokens lasting less than this many refresh windows are instead
+	// replaced once this fraction of their lifetime is left
+	tokenRefreshFraction = 4
+)
+
+// AppToken is an app access token from the oauth client credentials flow
+type AppToken struct {
+	// client the token was issued to
+	ClientID    string    `json:"client_id"`
+	AccessToken string    `json:"access_token"`
+	IssuedAt    time.Time `json:"issued_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// refreshAt returns when the token should be replaced
+// tokens stored without when they were issued use the full refresh window
+func (a *AppToken) refreshAt() time.Time {
+	window := tokenRefreshWindow
+	if !a.IssuedAt.IsZero() {
+		if w := a.ExpiresAt.Sub(a.IssuedAt) / tokenRefreshFraction; w < window {
+			window = w
+		}
+	}
+
+	return a.ExpiresAt.Add(-window)
+}
+
+// tokenCall is an app token request in flight, shared by every
+// helix request that needs a new token until it's done
+type tokenCall struct {
+	done  chan struct{}
+	token *AppToken
+	err   error
+}
+
+// tokenResponse is the data structure for the oauth token endpoint
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	TokenType   string `json:"token_type"`
+}
+
+// appToken returns an app access token to authorize helix requests with
+// the token is cached in memory and in the database, and replaced shortly before it expires
+// only one new token is requested at a time, without holding up requests that don't need it
+// it's empty if no client secret is configured
+func (t *Twitch) appToken(ctx context.Context) (string, error) {
+	if t.ClientSecret == "" {
+		return "", nil
+	}
+
+	t.tokenMu.Lock()
+	if t.token == nil {
+		stored, err := t.db.GetAppToken()
+		if err != nil {
+			fmt.Println("error getting stored app token:", err.Error())
+		}
+		if stored != nil && stored.ClientID == t.ClientID {
+			t.token = stored
+		}
+	}
+
+	old := t.token
+	if old != nil && time.Now().Before(old.refreshAt()) {
+		t.tokenMu.Unlock()
+		return old.AccessToken, nil
+	}
+
+	call := t.tokenCall
+	if call == nil {
+		call = &tokenCall{done: make(chan struct{})}
+		t.tokenCall = call
+		t.tokenMu.Unlock()
+
+		call.token, call.err = t.requestAppToken(ctx)
+
+		t.tokenMu.Lock()
+		t.tokenCall = nil
+		if call.err == nil {
+			t.token = call.token
+			err := t.db.SetAppToken(call.token)
+			if err != nil {
+				fmt.Println("error storing app token:", err.Error())
+			}
+		}
+		t.tokenMu.Unlock()
+		close(call.done)
+	} else {
+		t.tokenMu.Unlock()
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+
+	if call.err != nil {
+		// an old token is still better than none until it actually expires
+		if old != nil && time.Now().Before(old.ExpiresAt) {
+			fmt.Println("error refreshing app token:", call.err.Error())
+			return old.AccessToken, nil
+		}
+		return "", call.err
+	}
+
+	return call.token.AccessToken, nil
+}
+
+// invalidateToken stops using a token that helix rejected
+func (t *Twitch) invalidateToken(rejected string) {
+	t.tokenMu.Lock()
+	defer t.tokenMu.Unlock()
+
+	// another request may have replaced it already
+	if t.token != nil && t.token.AccessToken == rejected {
+		t.token = nil
+		err := t.db.SetAppToken(nil)
+		if err != nil {
+			fmt.Println("error deleting app token:", err.Error())
+		}
+	}
+}
+
+// requestAppToken gets a new app access token using the client credentials flow
+func (t *Twitch) requestAppToken(ctx context.Context) (*AppToken, error) {
+	form := url.Values{
+		"client_id":     {t.ClientID},
+		"client_secret": {t.ClientSecret},
+		"grant_type":    {"client_credentials"},
+	}
+
+	req, err := http.NewRequest("POST", t.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("app token request responded %s: %s", res.Status, body)
+	}
+
+	token := &tokenResponse{}
+	err = json.Unmarshal(body, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("app token request didn't return a token")
+	}
+
+	now := time.Now()
+	return &AppToken{
+		ClientID:    t.ClientID,
+		AccessToken: token.AccessToken,
+		IssuedAt:    now,
+		ExpiresAt:   now.Add(time.Duration(token.ExpiresIn) * time.Second),
+	}, nil
+}
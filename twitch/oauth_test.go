@@ -0,0 +1,124 @@
+package twitch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeTokens serves the oauth token endpoint, holding each request until release is closed
+type fakeTokens struct {
+	*httptest.Server
+	issued    int32
+	expiresIn int64
+	release   chan struct{}
+}
+
+func newFakeTokens(t *testing.T, expiresIn time.Duration) *fakeTokens {
+	f := &fakeTokens{expiresIn: int64(expiresIn / time.Second), release: make(chan struct{})}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-f.release
+		n := atomic.AddInt32(&f.issued, 1)
+		json.NewEncoder(w).Encode(&tokenResponse{
+			AccessToken: fmt.Sprint("token", n),
+			ExpiresIn:   f.expiresIn,
+			TokenType:   "bearer",
+		})
+	}))
+	t.Cleanup(f.Close)
+
+	return f
+}
+
+func newTokenTwitch(t *testing.T, tokens *fakeTokens) *Twitch {
+	tw := NewAPI("client", openTestDB(t))
+	tw.ClientSecret = "secret"
+	tw.TokenURL = tokens.URL
+	return tw
+}
+
+func TestAppTokenShared(t *testing.T) {
+	tokens := newFakeTokens(t, 60*24*time.Hour)
+	tw := newTokenTwitch(t, tokens)
+
+	var wg sync.WaitGroup
+	results := make(chan string, 10)
+	for i := 0; i < cap(results); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := tw.appToken(context.Background())
+			if err != nil {
+				t.Error(err)
+			}
+			results <- token
+		}()
+	}
+
+	// the token isn't locked while it's requested
+	waitFor(t, "a token request", func() bool {
+		tw.tokenMu.Lock()
+		defer tw.tokenMu.Unlock()
+		return tw.tokenCall != nil
+	})
+	invalidated := make(chan struct{})
+	go func() {
+		tw.invalidateToken("token0")
+		close(invalidated)
+	}()
+	select {
+	case <-invalidated:
+	case <-time.After(5 * time.Second):
+		t.Fatal("invalidating the token blocked on the token request")
+	}
+
+	close(tokens.release)
+	wg.Wait()
+	close(results)
+
+	for e := range results {
+		if e != "token1" {
+			t.Fatalf("expected every caller to get the shared token, got %q", e)
+		}
+	}
+	if n := atomic.LoadInt32(&tokens.issued); n != 1 {
+		t.Fatalf("expected one token request, got %d", n)
+	}
+}
+
+func TestAppTokenRefreshWindow(t *testing.T) {
+	// shorter than the refresh window, so it's replaced once a quarter of it is left
+	tokens := newFakeTokens(t, 40*time.Minute)
+	close(tokens.release)
+	tw := newTokenTwitch(t, tokens)
+
+	for i := 0; i < 3; i++ {
+		token, err := tw.appToken(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != "token1" {
+			t.Fatalf("expected the token to be reused, got %q", token)
+		}
+	}
+
+	// pretend 35 minutes have passed
+	tw.tokenMu.Lock()
+	tw.token.IssuedAt = tw.token.IssuedAt.Add(-35 * time.Minute)
+	tw.token.ExpiresAt = tw.token.ExpiresAt.Add(-35 * time.Minute)
+	tw.tokenMu.Unlock()
+
+	token, err := tw.appToken(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "token2" {
+		t.Fatalf("expected the token to be replaced, got %q", token)
+	}
+}
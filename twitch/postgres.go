@@ -0,0 +1,732 @@
+package twitch
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/coadler/twitch/internal/models"
+	"github.com/coadler/twitch/internal/models/games"
+	"github.com/coadler/twitch/internal/models/subscriptions"
+	"github.com/coadler/twitch/internal/models/twitchuser"
+	"github.com/coadler/twitch/internal/models/webhooks"
+)
+
+// Postgres stores everything in the schema from internal/models/schema
+// cached users and games are considered cached as of their updated_at, and
+// a row with an empty login or name records that twitch doesn't know of the id
+type Postgres struct {
+	db *sql.DB
+	// whether the subscriptions table exists to link cached users to
+	subscriptions bool
+}
+
+// NewPostgres returns storage using the tables in db
+func NewPostgres(db *sql.DB) *Postgres {
+	return &Postgres{db: db, subscriptions: true}
+}
+
+// NewPostgresCache returns a cache using only the twitch_user and games tables in db
+// for when everything else is stored somewhere else, like bolt
+func NewPostgresCache(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+// GetCachedUsers returns the cached twitch users out of ids, by id
+// expired entries are returned too, it's up to the caller to check CachedAt
+func (p *Postgres) GetCachedUsers(ids []string) (map[string]*CachedUser, error) {
+	users := map[string]*CachedUser{}
+	if len(ids) == 0 {
+		return users, nil
+	}
+
+	rows, err := twitchuser.Query(context.Background(), p.db, twitchuser.IDCol.In(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range rows {
+		user := &CachedUser{CachedAt: e.UpdatedAt}
+		if e.Login != "" {
+			user.User = &UserData{
+				ID:              e.ID,
+				Login:           e.Login,
+				DisplayName:     e.DisplayName,
+				Type:            e.Type,
+				BroadcasterType: e.BroadcasterType,
+				Description:     e.Description,
+				ProfileImageURL: e.ProfileImageURL,
+				OfflineImageURL: e.OfflineImageURL,
+				ViewCount:       e.ViewCount,
+			}
+		}
+		users[e.ID] = user
+	}
+
+	return users, nil
+}
+
+// CacheUsers caches twitch users by their id, along with
+// the ids of users twitch didn't return
+func (p *Postgres) CacheUsers(users []*UserData, missing []string) error {
+	ctx := context.Background()
+	return p.transact(ctx, func(tx *sql.Tx) error {
+		for _, e := range users {
+			err := twitchuser.Upsert(ctx, tx, &twitchuser.Row{
+				ID:              e.ID,
+				Login:           e.Login,
+				DisplayName:     e.DisplayName,
+				Type:            e.Type,
+				BroadcasterType: e.BroadcasterType,
+				Description:     e.Description,
+				ProfileImageURL: e.ProfileImageURL,
+				OfflineImageURL: e.OfflineImageURL,
+				ViewCount:       e.ViewCount,
+			})
+			if err != nil {
+				return err
+			}
+
+			if !p.subscriptions {
+				continue
+			}
+
+			// link the subscriptions to the user now that it's known
+			_, err = tx.ExecContext(ctx, `UPDATE public.subscriptions SET twitch_user_id = $1
+			WHERE twitch_login = $2 AND twitch_user_id IS DISTINCT FROM $1`, e.ID, normalizeName(e.Login))
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, e := range missing {
+			err := twitchuser.Upsert(ctx, tx, &twitchuser.Row{ID: e})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// InvalidateUser removes a twitch user from the cache by their username
+// so they're requested again the next time they're needed
+func (p *Postgres) InvalidateUser(twitchName string) error {
+	_, err := twitchuser.DeleteWhere(context.Background(), p.db, twitchuser.LoginCol.Equals(normalizeName(twitchName)))
+	return err
+}
+
+// GetCachedGames returns the cached twitch games out of ids, by id
+// expired entries are returned too, it's up to the caller to check CachedAt
+func (p *Postgres) GetCachedGames(ids []string) (map[string]*CachedGame, error) {
+	cached := map[string]*CachedGame{}
+	if len(ids) == 0 {
+		return cached, nil
+	}
+
+	rows, err := games.Query(context.Background(), p.db, games.IDCol.In(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range rows {
+		game := &CachedGame{CachedAt: e.UpdatedAt}
+		if e.Name != "" {
+			game.Game = &GameData{
+				ID:        e.ID,
+				Name:      e.Name,
+				BoxArtURL: e.BoxArtURL,
+			}
+		}
+		cached[e.ID] = game
+	}
+
+	return cached, nil
+}
+
+// CacheGames caches twitch games by their id, along with
+// the ids of games twitch didn't return
+func (p *Postgres) CacheGames(cache []*GameData, missing []string) error {
+	ctx := context.Background()
+	return p.transact(ctx, func(tx *sql.Tx) error {
+		for _, e := range cache {
+			err := games.Upsert(ctx, tx, &games.Row{
+				ID:        e.ID,
+				Name:      e.Name,
+				BoxArtURL: e.BoxArtURL,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, e := range missing {
+			// streams without a game have an empty game id, there's nothing to cache
+			if e == "" {
+				continue
+			}
+
+			err := games.Upsert(ctx, tx, &games.Row{ID: e})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// transact runs f in a transaction, committing it if f doesn't return an error
+func (p *Postgres) transact(ctx context.Context, f func(tx *sql.Tx) error) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = f(tx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// AddChannel adds a twitch channel to motitor and adds the channelID + webhook to be notified
+// the subscription is owned by the given bot, and can't be taken over by another
+// guild is the discord guild the channel is in, and can be empty if it's not known
+func (p *Postgres) AddChannel(twitchName, channel, guild, owner string, hook *Webhook) error {
+	if guild != "" && !validGuild(guild) {
+		return ErrInvalidGuild
+	}
+	twitchName = normalizeName(twitchName)
+	ctx := context.Background()
+
+	return p.transact(ctx, func(tx *sql.Tx) error {
+		rows, err := subscriptions.Query(ctx, tx, subscriptions.ChannelCol.Equals(channel))
+		if err != nil {
+			return err
+		}
+
+		var cur *subscriptions.Row
+		for _, e := range rows {
+			if e.TwitchLogin == twitchName {
+				cur = e
+			}
+		}
+		// unowned subscriptions are claimed by the bot that adds them again
+		if cur != nil && !visibleTo(cur.Owner, owner, false) {
+			return ErrNotOwner
+		}
+
+		err = webhooks.Upsert(ctx, tx, &webhooks.Row{ID: hook.ID, Token: hook.Token})
+		if err != nil {
+			return err
+		}
+
+		// a discord channel never moves between guilds, and its options
+		// are shared, so every subscription in it has the same ones
+		opts := models.Jsonb{}
+		if len(rows) > 0 {
+			opts = rows[0].Options
+			if guild == "" {
+				guild = rows[0].Guild
+			}
+		}
+		if guild != "" {
+			_, err = subscriptions.Set(ctx, tx, subscriptions.GuildCol.Equals(guild), subscriptions.ChannelCol.Equals(channel))
+			if err != nil {
+				return err
+			}
+		}
+
+		if cur != nil {
+			old := cur.WebhookID
+			cur.Guild = guild
+			cur.Owner = owner
+			cur.WebhookID = hook.ID
+			err = subscriptions.Update(ctx, tx, cur)
+			if err != nil {
+				return err
+			}
+
+			return deleteUnusedWebhook(ctx, tx, old)
+		}
+
+		row := &subscriptions.Row{
+			Channel:     channel,
+			Guild:       guild,
+			Options:     opts,
+			Owner:       owner,
+			TwitchLogin: twitchName,
+			WebhookID:   hook.ID,
+		}
+
+		// link the twitch user if they're already cached, otherwise it's done when they are
+		users, err := twitchuser.Query(ctx, tx, twitchuser.LoginCol.Equals(twitchName))
+		if err != nil {
+			return err
+		}
+		if len(users) > 0 {
+			row.TwitchUserID = sql.NullString{String: users[0].ID, Valid: true}
+		}
+
+		return subscriptions.Insert(ctx, tx, row)
+	})
+}
+
+// DeleteWebhook deletes the webhook a discord channel uses to receive updates for a twitch channel
+// if wID isn't empty it must match the id of the stored webhook
+// only the bot owning the subscription can delete it, unless all is set or it's unowned
+func (p *Postgres) DeleteWebhook(twitchName, wID, cID, owner string, all bool) error {
+	twitchName = normalizeName(twitchName)
+	ctx := context.Background()
+
+	return p.transact(ctx, func(tx *sql.Tx) error {
+		rows, err := subscriptions.Query(ctx, tx, models.AndClause(
+			subscriptions.TwitchLoginCol.Equals(twitchName),
+			subscriptions.ChannelCol.Equals(cID),
+		))
+		if err != nil {
+			return err
+		}
+
+		if len(rows) < 1 || !visibleTo(rows[0].Owner, owner, all) {
+			return ErrNotFound
+		}
+		if wID != "" && rows[0].WebhookID != wID {
+			return ErrNotFound
+		}
+
+		// the go live message and options are deleted along with it
+		_, err = subscriptions.Delete(ctx, tx, rows[0].ID)
+		if err != nil {
+			return err
+		}
+
+		return deleteUnusedWebhook(ctx, tx, rows[0].WebhookID)
+	})
+}
+
+// RemoveWebhook removes every subscription using a webhook that no longer exists
+func (p *Postgres) RemoveWebhook(hook *Webhook) error {
+	// the subscriptions using it are deleted along with it
+	_, err := webhooks.Delete(context.Background(), p.db, hook.ID)
+	return err
+}
+
+// RepairChannelCounts has nothing to repair, the tracked
+// twitch channels are always derived from the subscriptions
+func (p *Postgres) RepairChannelCounts() (int, error) {
+	return 0, nil
+}
+
+// GetAllTwitchChannels returns all the twitch channels being tracked
+func (p *Postgres) GetAllTwitchChannels() ([]string, error) {
+	const sqlstr = `SELECT DISTINCT twitch_login FROM public.subscriptions`
+
+	rows, err := p.db.QueryContext(context.Background(), sqlstr)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var channels []string
+	for rows.Next() {
+		var login string
+		err = rows.Scan(&login)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, login)
+	}
+
+	return channels, rows.Err()
+}
+
+// GetWebhooksByTwitchName returns a slice of all the webhooks for a twitch channel
+func (p *Postgres) GetWebhooksByTwitchName(twitchName string) ([]*Webhook, error) {
+	const sqlstr = `SELECT s.channel, w.id, w.token
+	FROM public.subscriptions s JOIN public.webhooks w ON w.id = s.webhook_id
+	WHERE s.twitch_login = $1`
+
+	return p.queryWebhooks(sqlstr, normalizeName(twitchName))
+}
+
+// GetWebhookByChannel returns the webhook a discord channel uses for a subscription visible
+// to the given bot, or nil if there isn't one. all can be set to see every subscription
+func (p *Postgres) GetWebhookByChannel(cID, owner string, all bool) (*Webhook, error) {
+	const sqlstr = `SELECT s.channel, w.id, w.token
+	FROM public.subscriptions s JOIN public.webhooks w ON w.id = s.webhook_id
+	WHERE s.channel = $1 AND ($3 OR s.owner = '' OR s.owner = $2) LIMIT 1`
+
+	hooks, err := p.queryWebhooks(sqlstr, cID, owner, all)
+	if err != nil || len(hooks) < 1 {
+		return nil, err
+	}
+
+	return hooks[0], nil
+}
+
+// GetTwitchNamesByChannel return all the tracked twitch names from a discord channel
+// that are owned by the given bot or unowned, or every name if all is set
+func (p *Postgres) GetTwitchNamesByChannel(cID, owner string, all bool) ([]string, error) {
+	rows, err := subscriptions.Query(context.Background(), p.db, subscriptions.ChannelCol.Equals(cID))
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range rows {
+		if visibleTo(e.Owner, owner, all) {
+			names = append(names, e.TwitchLogin)
+		}
+	}
+
+	return names, nil
+}
+
+// GetOwnersByTwitchName returns the owner of every subscription to a twitch channel
+// keyed by discord channel id
+func (p *Postgres) GetOwnersByTwitchName(twitchName string) (map[string]string, error) {
+	rows, err := subscriptions.Query(context.Background(), p.db, subscriptions.TwitchLoginCol.Equals(normalizeName(twitchName)))
+	if err != nil {
+		return nil, err
+	}
+
+	owners := map[string]string{}
+	for _, e := range rows {
+		owners[e.Channel] = e.Owner
+	}
+
+	return owners, nil
+}
+
+// GetSubscriptionsByShard returns every subscription in a guild handled by a shard
+// that is owned by the given bot or unowned, or every bot's if all is set
+// subscriptions added without a guild, or with one that isn't a snowflake,
+// can't be assigned to a shard and are never returned
+func (p *Postgres) GetSubscriptionsByShard(shard, shardCount int, owner string, all bool) ([]*Subscription, error) {
+	const sqlstr = `SELECT s.channel, s.guild, s.twitch_login, s.owner, w.id, w.token
+	FROM public.subscriptions s JOIN public.webhooks w ON w.id = s.webhook_id
+	WHERE s.guild <> ''`
+
+	rows, err := p.db.QueryContext(context.Background(), sqlstr)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subs []*Subscription
+	for rows.Next() {
+		sub := &Subscription{Webhook: &Webhook{}}
+		err = rows.Scan(&sub.Channel, &sub.Guild, &sub.TwitchName, &sub.Owner, &sub.Webhook.ID, &sub.Webhook.Token)
+		if err != nil {
+			return nil, err
+		}
+		sub.Webhook.Channel = sub.Channel
+
+		if !visibleTo(sub.Owner, owner, all) {
+			continue
+		}
+
+		// skip guilds stored before they were validated instead of
+		// failing the shard for every other subscription
+		s, err := guildShard(sub.Guild, shardCount)
+		if err == nil && s == shard {
+			subs = append(subs, sub)
+		}
+	}
+
+	return subs, rows.Err()
+}
+
+// GetChannelOptions returns the notification settings of a discord channel
+// channels that were never configured get the default settings
+func (p *Postgres) GetChannelOptions(cID string) (*ChannelOptions, error) {
+	rows, err := subscriptions.Query(context.Background(), p.db, subscriptions.ChannelCol.Equals(cID))
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &ChannelOptions{}
+	if len(rows) < 1 {
+		return opts, nil
+	}
+
+	// every subscription in a channel has the same options
+	raw, err := json.Marshal(rows[0].Options)
+	if err != nil {
+		return nil, err
+	}
+
+	return opts, json.Unmarshal(raw, opts)
+}
+
+// SetChannelOptions changes the notification settings of a discord channel
+// the channel must track a twitch channel owned by owner or unowned, unless all is set
+func (p *Postgres) SetChannelOptions(cID, owner string, all bool, opts *ChannelOptions) error {
+	raw, err := json.Marshal(opts)
+	if err != nil {
+		return err
+	}
+
+	j := models.Jsonb{}
+	err = json.Unmarshal(raw, &j)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	return p.transact(ctx, func(tx *sql.Tx) error {
+		rows, err := subscriptions.Query(ctx, tx, subscriptions.ChannelCol.Equals(cID))
+		if err != nil {
+			return err
+		}
+
+		owned := false
+		for _, e := range rows {
+			if visibleTo(e.Owner, owner, all) {
+				owned = true
+				break
+			}
+		}
+		if !owned {
+			return ErrNotFound
+		}
+
+		_, err = subscriptions.Set(ctx, tx, subscriptions.OptionsCol.Equals(j), subscriptions.ChannelCol.Equals(cID))
+		return err
+	})
+}
+
+// queryWebhooks returns the webhooks from a query selecting their channel, id and token
+// GetSubscriptionOptions returns the notification settings of a discord channel's
+// subscription to a twitch channel, subscriptions that were never configured get the default settings
+func (p *Postgres) GetSubscriptionOptions(twitchName, cID string) (*SubscriptionOptions, error) {
+	const sqlstr = `SELECT options FROM public.subscription_options WHERE twitch_login = $1 AND channel = $2`
+
+	opts := &SubscriptionOptions{}
+	var raw []byte
+	err := p.db.QueryRowContext(context.Background(), sqlstr, normalizeName(twitchName), cID).Scan(&raw)
+	if err == sql.ErrNoRows {
+		return opts, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return opts, json.Unmarshal(raw, opts)
+}
+
+// SetSubscriptionOptions changes the notification settings of a discord channel's subscription to a twitch channel
+// the subscription must be owned by owner or unowned, unless all is set
+func (p *Postgres) SetSubscriptionOptions(twitchName, cID, owner string, all bool, opts *SubscriptionOptions) error {
+	const sqlstr = `INSERT INTO public.subscription_options (
+		twitch_login, channel, options
+	) VALUES (
+		$1, $2, $3
+	) ON CONFLICT (twitch_login, channel) DO UPDATE SET options = $3`
+
+	raw, err := json.Marshal(opts)
+	if err != nil {
+		return err
+	}
+
+	twitchName = normalizeName(twitchName)
+	ctx := context.Background()
+	return p.transact(ctx, func(tx *sql.Tx) error {
+		rows, err := subscriptions.Query(ctx, tx, models.AndClause(
+			subscriptions.TwitchLoginCol.Equals(twitchName),
+			subscriptions.ChannelCol.Equals(cID),
+		))
+		if err != nil {
+			return err
+		}
+
+		if len(rows) < 1 || !visibleTo(rows[0].Owner, owner, all) {
+			return ErrNotFound
+		}
+
+		_, err = tx.ExecContext(ctx, sqlstr, twitchName, cID, raw)
+		return err
+	})
+}
+
+func (p *Postgres) queryWebhooks(sqlstr string, args ...interface{}) ([]*Webhook, error) {
+	rows, err := p.db.QueryContext(context.Background(), sqlstr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hooks []*Webhook
+	for rows.Next() {
+		hook := &Webhook{}
+		err = rows.Scan(&hook.Channel, &hook.ID, &hook.Token)
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, hook)
+	}
+
+	return hooks, rows.Err()
+}
+
+// deleteUnusedWebhook deletes a webhook once no subscription uses it
+func deleteUnusedWebhook(ctx context.Context, db models.DB, id string) error {
+	const sqlstr = `DELETE FROM public.webhooks WHERE id = $1
+	AND NOT EXISTS (SELECT 1 FROM public.subscriptions WHERE webhook_id = $1)`
+
+	_, err := db.ExecContext(ctx, sqlstr, id)
+	return err
+}
+
+// GetLiveStreams returns the streams that were live as of the last poll, by user id
+func (p *Postgres) GetLiveStreams() (map[string]*LiveStream, error) {
+	const sqlstr = `SELECT user_id, data FROM public.live_streams`
+
+	rows, err := p.db.QueryContext(context.Background(), sqlstr)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	streams := map[string]*LiveStream{}
+	for rows.Next() {
+		var (
+			id  string
+			raw []byte
+		)
+		err = rows.Scan(&id, &raw)
+		if err != nil {
+			return nil, err
+		}
+
+		stream := &LiveStream{}
+		err = json.Unmarshal(raw, stream)
+		if err != nil {
+			return nil, err
+		}
+
+		if stream.Stream == nil {
+			continue
+		}
+		if stream.Session == nil {
+			stream.Session = newSession(stream.Stream)
+		}
+		streams[id] = stream
+	}
+
+	return streams, rows.Err()
+}
+
+// SetLiveStreams replaces the stored live streams with streams, by user id
+func (p *Postgres) SetLiveStreams(streams map[string]*LiveStream) error {
+	ctx := context.Background()
+	return p.transact(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `DELETE FROM public.live_streams`)
+		if err != nil {
+			return err
+		}
+
+		for i, e := range streams {
+			raw, err := json.Marshal(e)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.ExecContext(ctx, `INSERT INTO public.live_streams (user_id, data) VALUES ($1, $2)`, i, raw)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetLiveMessages returns the ids of the go live messages sent for a
+// twitch channel's current stream, by discord channel id
+func (p *Postgres) GetLiveMessages(twitchName string) (map[string]string, error) {
+	const sqlstr = `SELECT channel, message_id FROM public.live_messages WHERE twitch_login = $1`
+
+	rows, err := p.db.QueryContext(context.Background(), sqlstr, normalizeName(twitchName))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := map[string]string{}
+	for rows.Next() {
+		var cID, id string
+		err = rows.Scan(&cID, &id)
+		if err != nil {
+			return nil, err
+		}
+		messages[cID] = id
+	}
+
+	return messages, rows.Err()
+}
+
+// SetLiveMessage stores the id of the go live message sent to a discord channel
+func (p *Postgres) SetLiveMessage(twitchName, cID, messageID string) error {
+	const sqlstr = `INSERT INTO public.live_messages (
+		twitch_login, channel, message_id
+	) VALUES (
+		$1, $2, $3
+	) ON CONFLICT (twitch_login, channel) DO UPDATE SET message_id = $3`
+
+	_, err := p.db.ExecContext(context.Background(), sqlstr, normalizeName(twitchName), cID, messageID)
+	return err
+}
+
+// DeleteLiveMessage stops tracking a go live message
+// nothing is deleted if a newer message was stored since
+func (p *Postgres) DeleteLiveMessage(twitchName, cID, messageID string) error {
+	const sqlstr = `DELETE FROM public.live_messages
+	WHERE twitch_login = $1 AND channel = $2 AND message_id = $3`
+
+	_, err := p.db.ExecContext(context.Background(), sqlstr, normalizeName(twitchName), cID, messageID)
+	return err
+}
+
+// GetAppToken returns the stored twitch app access token, or nil if there isn't one
+func (p *Postgres) GetAppToken() (*AppToken, error) {
+	var raw []byte
+	err := p.db.QueryRowContext(context.Background(), `SELECT value FROM public.meta WHERE key = 'app-token'`).Scan(&raw)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	token := new(AppToken)
+	return token, json.Unmarshal(raw, token)
+}
+
+// SetAppToken stores the twitch app access token, or deletes it if token is nil
+func (p *Postgres) SetAppToken(token *AppToken) error {
+	ctx := context.Background()
+	if token == nil {
+		_, err := p.db.ExecContext(ctx, `DELETE FROM public.meta WHERE key = 'app-token'`)
+		return err
+	}
+
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	const sqlstr = `INSERT INTO public.meta (key, value) VALUES ('app-token', $1)
+	ON CONFLICT (key) DO UPDATE SET value = $1`
+
+	_, err = p.db.ExecContext(ctx, sqlstr, raw)
+	return err
+}
+
+// Close closes the postgres connection pool
+func (p *Postgres) Close() {
+	p.db.Close()
+}
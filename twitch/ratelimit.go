@@ -0,0 +1,109 @@
+package twitch
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit is the helix rate limit as of the last response
+type RateLimit struct {
+	// requests allowed per window
+	Limit int
+	// requests left in the current window
+	Remaining int
+	// when the window resets
+	Reset time.Time
+}
+
+// rateLimit tracks the helix rate limit from the headers of each response
+type rateLimit struct {
+	mu        sync.Mutex
+	known     bool
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+// wait blocks until a request can be made without going over the rate limit
+func (r *rateLimit) wait(ctx context.Context) error {
+	r.mu.Lock()
+	var wait time.Duration
+	if r.known {
+		if r.remaining < 1 {
+			wait = r.reset.Sub(time.Now())
+		}
+		// reserve a request so concurrent callers don't all use the last one
+		r.remaining--
+	}
+	r.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// update records the rate limit returned by helix
+func (r *rateLimit) update(h http.Header) {
+	remaining, err := strconv.Atoi(h.Get("Ratelimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(h.Get("Ratelimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	limit, _ := strconv.Atoi(h.Get("Ratelimit-Limit"))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.known = true
+	r.limit = limit
+	r.remaining = remaining
+	r.reset = time.Unix(reset, 0)
+}
+
+// exhausted makes requests wait for the window to reset
+// helix can reject requests before the remaining count says it will
+func (r *rateLimit) exhausted() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.remaining = 0
+	if !r.known || r.reset.Before(time.Now()) {
+		// without a reset time, wait for the shortest helix window
+		r.reset = time.Now().Add(time.Second)
+	}
+	r.known = true
+}
+
+// get returns a copy of the rate limit
+func (r *rateLimit) get() RateLimit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	remaining := r.remaining
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return RateLimit{
+		Limit:     r.limit,
+		Remaining: remaining,
+		Reset:     r.reset,
+	}
+}
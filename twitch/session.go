@@ -0,0 +1,83 @@
+package twitch
+
+import "time"
+
+// Session is a summary of a single stream built from
+// the samples taken each time the stream is polled
+type Session struct {
+	StartedAt time.Time `json:"started_at"`
+	// last time the stream was seen live
+	LastSeen time.Time `json:"last_seen"`
+	Title    string    `json:"title"`
+	// game ids in the order they were first played
+	Games       []string `json:"games"`
+	PeakViewers int      `json:"peak_viewers"`
+	// amount of polls and the sum of their viewer counts
+	Samples      int `json:"samples"`
+	TotalViewers int `json:"total_viewers"`
+
+	// the title and game subscribers were last notified of, and when
+	NotifiedTitle string    `json:"notified_title"`
+	NotifiedGame  string    `json:"notified_game"`
+	NotifiedAt    time.Time `json:"notified_at"`
+}
+
+// newSession starts a session from the first time a stream is seen live
+func newSession(channel *ChannelData) *Session {
+	s := &Session{
+		StartedAt: channel.StartedAt,
+	}
+	s.sample(channel)
+	s.notified(channel)
+
+	return s
+}
+
+// sample records the state of the stream from a single poll
+func (s *Session) sample(channel *ChannelData) {
+	s.LastSeen = time.Now()
+	s.Title = channel.Title
+	s.Samples++
+	s.TotalViewers += channel.ViewerCount
+
+	if channel.ViewerCount > s.PeakViewers {
+		s.PeakViewers = channel.ViewerCount
+	}
+
+	if channel.GameID == "" {
+		return
+	}
+	for _, e := range s.Games {
+		if e == channel.GameID {
+			return
+		}
+	}
+	s.Games = append(s.Games, channel.GameID)
+}
+
+// Duration is how long the stream was live for
+func (s *Session) Duration() time.Duration {
+	return s.LastSeen.Sub(s.StartedAt)
+}
+
+// AverageViewers is the mean viewer count across every sample
+func (s *Session) AverageViewers() int {
+	if s.Samples == 0 {
+		return 0
+	}
+	return s.TotalViewers / s.Samples
+}
+
+// notified records that subscribers were sent the current state of the stream
+func (s *Session) notified(channel *ChannelData) {
+	s.NotifiedTitle = channel.Title
+	s.NotifiedGame = channel.GameID
+	s.NotifiedAt = time.Now()
+}
+
+// copy returns a copy of the session that's safe to use from another goroutine
+func (s *Session) copy() *Session {
+	c := *s
+	c.Games = append([]string(nil), s.Games...)
+	return &c
+}
@@ -0,0 +1,47 @@
+package twitch
+
+// Storage is where subscriptions, live state and metadata are kept
+// Database stores them in bolt, and Postgres in the schema
+// from internal/models/schema
+// methods taking an owner and all only see that bot's subscriptions and
+// unowned ones, unless all is set to see every bot's
+type Storage interface {
+	Cache
+
+	// AddChannel subscribes a discord channel to a twitch channel, owned by the given bot
+	AddChannel(twitchName, channel, guild, owner string, hook *Webhook) error
+	// DeleteWebhook unsubscribes a discord channel from a twitch channel
+	DeleteWebhook(twitchName, wID, cID, owner string, all bool) error
+	// RemoveWebhook removes every subscription using a webhook that no longer exists
+	RemoveWebhook(hook *Webhook) error
+	// RepairChannelCounts recomputes how many discord channels follow each twitch channel
+	RepairChannelCounts() (int, error)
+
+	GetAllTwitchChannels() ([]string, error)
+	GetWebhooksByTwitchName(twitchName string) ([]*Webhook, error)
+	GetWebhookByChannel(cID, owner string, all bool) (*Webhook, error)
+	GetTwitchNamesByChannel(cID, owner string, all bool) ([]string, error)
+	GetOwnersByTwitchName(twitchName string) (map[string]string, error)
+	GetSubscriptionsByShard(shard, shardCount int, owner string, all bool) ([]*Subscription, error)
+
+	GetChannelOptions(cID string) (*ChannelOptions, error)
+	SetChannelOptions(cID, owner string, all bool, opts *ChannelOptions) error
+	GetSubscriptionOptions(twitchName, cID string) (*SubscriptionOptions, error)
+	SetSubscriptionOptions(twitchName, cID, owner string, all bool, opts *SubscriptionOptions) error
+
+	GetLiveStreams() (map[string]*LiveStream, error)
+	SetLiveStreams(streams map[string]*LiveStream) error
+	GetLiveMessages(twitchName string) (map[string]string, error)
+	SetLiveMessage(twitchName, cID, messageID string) error
+	DeleteLiveMessage(twitchName, cID, messageID string) error
+
+	GetAppToken() (*AppToken, error)
+	SetAppToken(token *AppToken) error
+
+	Close()
+}
+
+var (
+	_ Storage = &Database{}
+	_ Storage = &Postgres{}
+)
@@ -0,0 +1,476 @@
+package twitch
+
+import (
+	"database/sql"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+)
+
+// storages opens an empty instance of each storage backend
+// postgres needs PG_DSN to point at a database created from internal/models/schema/dump.sql,
+// and every table in it is emptied before each test
+var storages = []struct {
+	name string
+	open func(t *testing.T) Storage
+}{
+	{"bolt", func(t *testing.T) Storage {
+		return openTestDB(t)
+	}},
+	{"postgres", func(t *testing.T) Storage {
+		dsn := os.Getenv("PG_DSN")
+		if dsn == "" {
+			t.Skip("PG_DSN isn't set")
+		}
+
+		db, err := sql.Open("postgres", dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = db.Exec(`TRUNCATE public.subscriptions, public.webhooks, public.twitch_user,
+		public.games, public.live_streams, public.live_messages, public.subscription_options, public.meta CASCADE`)
+		if err != nil {
+			db.Close()
+			t.Fatal(err)
+		}
+
+		p := NewPostgres(db)
+		t.Cleanup(p.Close)
+		return p
+	}},
+}
+
+func TestStorage(t *testing.T) {
+	for _, e := range []struct {
+		name string
+		run  func(t *testing.T, s Storage)
+	}{
+		{"subscriptions", testSubscriptions},
+		{"owners", testOwners},
+		{"delete", testDelete},
+		{"remove webhook", testRemoveWebhook},
+		{"shards", testShards},
+		{"channel options", testChannelOptions},
+		{"subscription options", testSubscriptionOptions},
+		{"live streams", testLiveStreams},
+		{"live messages", testLiveMessages},
+		{"app token", testAppToken},
+		{"user cache", testUserCache},
+		{"game cache", testGameCache},
+	} {
+		for _, storage := range storages {
+			t.Run(storage.name+"/"+e.name, func(t *testing.T) {
+				e.run(t, storage.open(t))
+			})
+		}
+	}
+}
+
+func mustAdd(t *testing.T, s Storage, twitchName, channel, guild, owner string, hook *Webhook) {
+	t.Helper()
+	err := s.AddChannel(twitchName, channel, guild, owner, hook)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func expectErr(t *testing.T, err, expected error) {
+	t.Helper()
+	if err != expected {
+		t.Fatalf("expected %v, got %v", expected, err)
+	}
+}
+
+func expectEqual(t *testing.T, actual, expected interface{}) {
+	t.Helper()
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+}
+
+func sorted(s []string) []string {
+	sort.Strings(s)
+	return s
+}
+
+func testSubscriptions(t *testing.T, s Storage) {
+	hook1 := &Webhook{Channel: "1", ID: "hook1", Token: "token1"}
+	hook2 := &Webhook{Channel: "2", ID: "hook2", Token: "token2"}
+	mustAdd(t, s, "Streamer", "1", testGuild, "bot", hook1)
+	mustAdd(t, s, "other", "1", testGuild, "bot", hook1)
+	mustAdd(t, s, "streamer", "2", "", "bot2", hook2)
+	// adding a subscription again only replaces its webhook
+	mustAdd(t, s, "STREAMER", "1", testGuild, "bot", hook1)
+
+	channels, err := s.GetAllTwitchChannels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, sorted(channels), []string{"other", "streamer"})
+
+	hooks, err := s.GetWebhooksByTwitchName("Streamer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Slice(hooks, func(i, j int) bool { return hooks[i].Channel < hooks[j].Channel })
+	expectEqual(t, hooks, []*Webhook{hook1, hook2})
+
+	for _, c := range []struct {
+		channel, owner string
+		all            bool
+		names          []string
+		hook           *Webhook
+	}{
+		{"1", "bot", false, []string{"other", "streamer"}, hook1},
+		{"1", "bot2", false, nil, nil},
+		{"1", "bot2", true, []string{"other", "streamer"}, hook1},
+		{"2", "bot2", false, []string{"streamer"}, hook2},
+		{"3", "bot", true, nil, nil},
+	} {
+		names, err := s.GetTwitchNamesByChannel(c.channel, c.owner, c.all)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(names) == 0 {
+			names = nil
+		}
+		expectEqual(t, sorted(names), c.names)
+
+		hook, err := s.GetWebhookByChannel(c.channel, c.owner, c.all)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectEqual(t, hook, c.hook)
+	}
+
+	owners, err := s.GetOwnersByTwitchName("streamer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, owners, map[string]string{"1": "bot", "2": "bot2"})
+
+	changed, err := s.RepairChannelCounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, changed, 0)
+}
+
+func testOwners(t *testing.T, s Storage) {
+	hook := &Webhook{Channel: "1", ID: "hook1", Token: "token1"}
+	mustAdd(t, s, "streamer", "1", testGuild, "bot", hook)
+
+	expectErr(t, s.AddChannel("streamer", "1", testGuild, "bot2", hook), ErrNotOwner)
+	expectErr(t, s.DeleteWebhook("streamer", "", "1", "bot2", false), ErrNotFound)
+	expectErr(t, s.SetChannelOptions("1", "bot2", false, &ChannelOptions{}), ErrNotFound)
+	expectErr(t, s.SetSubscriptionOptions("streamer", "1", "bot2", false, &SubscriptionOptions{}), ErrNotFound)
+	expectErr(t, s.AddChannel("other", "1", "guild", "bot", hook), ErrInvalidGuild)
+
+	// admins can manage every subscription
+	expectErr(t, s.SetChannelOptions("1", "admin", true, &ChannelOptions{}), nil)
+	expectErr(t, s.SetSubscriptionOptions("streamer", "1", "admin", true, &SubscriptionOptions{}), nil)
+	expectErr(t, s.DeleteWebhook("streamer", "", "1", "admin", true), nil)
+}
+
+func testDelete(t *testing.T, s Storage) {
+	hook1 := &Webhook{Channel: "1", ID: "hook1", Token: "token1"}
+	mustAdd(t, s, "streamer", "1", testGuild, "bot", hook1)
+	mustAdd(t, s, "other", "1", testGuild, "bot", hook1)
+	mustAdd(t, s, "streamer", "2", "", "bot", &Webhook{Channel: "2", ID: "hook2", Token: "token2"})
+	expectErr(t, s.SetChannelOptions("1", "bot", false, &ChannelOptions{OfflineSummary: true}), nil)
+	expectErr(t, s.SetLiveMessage("streamer", "1", "message"), nil)
+	expectErr(t, s.SetSubscriptionOptions("streamer", "1", "bot", false, &SubscriptionOptions{StreamUpdates: true}), nil)
+
+	// the webhook id has to match if it's given
+	expectErr(t, s.DeleteWebhook("streamer", "hook2", "1", "bot", false), ErrNotFound)
+	expectErr(t, s.DeleteWebhook("Streamer", "hook1", "1", "bot", false), nil)
+	expectErr(t, s.DeleteWebhook("streamer", "", "1", "bot", false), ErrNotFound)
+
+	messages, err := s.GetLiveMessages("streamer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, len(messages), 0)
+
+	// subscribing again starts with the default options
+	mustAdd(t, s, "streamer", "1", testGuild, "bot", hook1)
+	subOpts, err := s.GetSubscriptionOptions("streamer", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, subOpts, &SubscriptionOptions{})
+	expectErr(t, s.DeleteWebhook("streamer", "", "1", "bot", false), nil)
+
+	channels, err := s.GetAllTwitchChannels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, sorted(channels), []string{"other", "streamer"})
+
+	// the options stay until nothing is tracked in the channel anymore
+	opts, err := s.GetChannelOptions("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, opts, &ChannelOptions{OfflineSummary: true})
+
+	expectErr(t, s.DeleteWebhook("other", "", "1", "bot", false), nil)
+	opts, err = s.GetChannelOptions("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, opts, &ChannelOptions{})
+
+	channels, err = s.GetAllTwitchChannels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, channels, []string{"streamer"})
+}
+
+func testRemoveWebhook(t *testing.T, s Storage) {
+	hook1 := &Webhook{Channel: "1", ID: "hook1", Token: "token1"}
+	replaced := &Webhook{Channel: "1", ID: "replaced", Token: "token"}
+	mustAdd(t, s, "streamer", "1", testGuild, "bot", hook1)
+	mustAdd(t, s, "other", "1", testGuild, "bot", hook1)
+	// the channel was given a new webhook for third since hook1 was sent to
+	mustAdd(t, s, "third", "1", testGuild, "bot", replaced)
+	mustAdd(t, s, "streamer", "2", "", "bot", &Webhook{Channel: "2", ID: "hook2", Token: "token2"})
+
+	expectErr(t, s.RemoveWebhook(hook1), nil)
+
+	names, err := s.GetTwitchNamesByChannel("1", "bot", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, names, []string{"third"})
+
+	channels, err := s.GetAllTwitchChannels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, sorted(channels), []string{"streamer", "third"})
+}
+
+func testShards(t *testing.T, s Storage) {
+	hook := &Webhook{Channel: "1", ID: "hook1", Token: "token1"}
+	mustAdd(t, s, "streamer", "1", testGuild, "bot", hook)
+	mustAdd(t, s, "other", "1", testGuild, "bot2", hook)
+	// subscriptions without a guild can't be assigned to a shard
+	mustAdd(t, s, "streamer", "2", "", "bot", &Webhook{Channel: "2", ID: "hook2", Token: "token2"})
+
+	shard, err := guildShard(testGuild, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subs, err := s.GetSubscriptionsByShard(shard, 4, "bot", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, subs, []*Subscription{{
+		Channel:    "1",
+		Guild:      testGuild,
+		TwitchName: "streamer",
+		Owner:      "bot",
+		Webhook:    hook,
+	}})
+
+	subs, err = s.GetSubscriptionsByShard(shard, 4, "bot", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, len(subs), 2)
+
+	subs, err = s.GetSubscriptionsByShard((shard+1)%4, 4, "bot", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, len(subs), 0)
+}
+
+func testChannelOptions(t *testing.T, s Storage) {
+	opts, err := s.GetChannelOptions("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, opts, &ChannelOptions{})
+
+	// nothing is tracked in the channel yet
+	expectErr(t, s.SetChannelOptions("1", "bot", false, &ChannelOptions{OfflineSummary: true}), ErrNotFound)
+
+	mustAdd(t, s, "streamer", "1", testGuild, "bot", &Webhook{Channel: "1", ID: "hook1", Token: "token1"})
+	expected := &ChannelOptions{OfflineSummary: true}
+	expectErr(t, s.SetChannelOptions("1", "bot", false, expected), nil)
+
+	opts, err = s.GetChannelOptions("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, opts, expected)
+}
+
+func testSubscriptionOptions(t *testing.T, s Storage) {
+	opts, err := s.GetSubscriptionOptions("streamer", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, opts, &SubscriptionOptions{})
+
+	// the channel doesn't follow streamer yet
+	hook := &Webhook{Channel: "1", ID: "hook1", Token: "token1"}
+	mustAdd(t, s, "other", "1", testGuild, "bot", hook)
+	expectErr(t, s.SetSubscriptionOptions("streamer", "1", "bot", false, &SubscriptionOptions{StreamUpdates: true}), ErrNotFound)
+
+	mustAdd(t, s, "streamer", "1", testGuild, "bot", hook)
+	expected := &SubscriptionOptions{StreamUpdates: true}
+	expectErr(t, s.SetSubscriptionOptions("Streamer", "1", "bot", false, expected), nil)
+
+	opts, err = s.GetSubscriptionOptions("STREAMER", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, opts, expected)
+
+	// the other subscriptions in the channel keep their own options
+	opts, err = s.GetSubscriptionOptions("other", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, opts, &SubscriptionOptions{})
+}
+
+func testLiveStreams(t *testing.T, s Storage) {
+	started := time.Unix(1500000000, 0)
+	stream := &ChannelData{ID: "stream", UserID: "1", UserLogin: "streamer", GameID: "1", Title: "title", StartedAt: started}
+	expectErr(t, s.SetLiveStreams(map[string]*LiveStream{
+		"1": {Stream: stream, Session: newSession(stream)},
+	}), nil)
+
+	streams, err := s.GetLiveStreams()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(streams) != 1 || streams["1"] == nil {
+		t.Fatalf("expected the stored stream, got %v", streams)
+	}
+	expectEqual(t, streams["1"].Stream.UserLogin, "streamer")
+	expectEqual(t, streams["1"].Session.Title, "title")
+	if !streams["1"].Session.StartedAt.Equal(started) {
+		t.Fatalf("expected the session to start at %s, got %s", started, streams["1"].Session.StartedAt)
+	}
+
+	// the stored streams are replaced
+	expectErr(t, s.SetLiveStreams(map[string]*LiveStream{}), nil)
+	streams, err = s.GetLiveStreams()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, len(streams), 0)
+}
+
+func testLiveMessages(t *testing.T, s Storage) {
+	expectErr(t, s.SetLiveMessage("Streamer", "1", "old"), nil)
+	expectErr(t, s.SetLiveMessage("streamer", "1", "new"), nil)
+	expectErr(t, s.SetLiveMessage("streamer", "2", "message"), nil)
+
+	messages, err := s.GetLiveMessages("STREAMER")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, messages, map[string]string{"1": "new", "2": "message"})
+
+	// a newer message isn't deleted along with an old one
+	expectErr(t, s.DeleteLiveMessage("streamer", "1", "old"), nil)
+	expectErr(t, s.DeleteLiveMessage("streamer", "2", "message"), nil)
+
+	messages, err = s.GetLiveMessages("streamer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectEqual(t, messages, map[string]string{"1": "new"})
+}
+
+func testAppToken(t *testing.T, s Storage) {
+	token, err := s.GetAppToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != nil {
+		t.Fatalf("expected no token, got %v", token)
+	}
+
+	expected := &AppToken{ClientID: "client", AccessToken: "token", ExpiresAt: time.Unix(1500000000, 0)}
+	expectErr(t, s.SetAppToken(expected), nil)
+
+	token, err = s.GetAppToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token == nil || token.ClientID != expected.ClientID || token.AccessToken != expected.AccessToken || !token.ExpiresAt.Equal(expected.ExpiresAt) {
+		t.Fatalf("expected %v, got %v", expected, token)
+	}
+
+	expectErr(t, s.SetAppToken(nil), nil)
+	token, err = s.GetAppToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != nil {
+		t.Fatalf("expected the token to be deleted, got %v", token)
+	}
+}
+
+func testUserCache(t *testing.T, s Storage) {
+	user := &UserData{ID: "1", Login: "streamer", DisplayName: "Streamer"}
+	expectErr(t, s.CacheUsers([]*UserData{user}, []string{"2"}), nil)
+
+	users, err := s.GetCachedUsers([]string{"1", "2", "3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 2 || users["1"] == nil || users["2"] == nil {
+		t.Fatalf("expected a cached and a missing user, got %v", users)
+	}
+	expectEqual(t, users["1"].User, user)
+	// users twitch doesn't know of are cached without data
+	if users["2"].User != nil {
+		t.Fatalf("expected user 2 to be missing, got %v", users["2"].User)
+	}
+	for id, e := range users {
+		if time.Since(e.CachedAt) > time.Minute {
+			t.Fatalf("expected user %s to have just been cached, it was cached at %s", id, e.CachedAt)
+		}
+	}
+
+	expectErr(t, s.InvalidateUser("STREAMER"), nil)
+	users, err = s.GetCachedUsers([]string{"1", "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users["2"] == nil {
+		t.Fatalf("expected only the missing user to be left, got %v", users)
+	}
+}
+
+func testGameCache(t *testing.T, s Storage) {
+	game := &GameData{ID: "1", Name: "game", BoxArtURL: "box art"}
+	expectErr(t, s.CacheGames([]*GameData{game}, []string{"2"}), nil)
+
+	games, err := s.GetCachedGames([]string{"1", "2", "3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 2 || games["1"] == nil || games["2"] == nil {
+		t.Fatalf("expected a cached and a missing game, got %v", games)
+	}
+	expectEqual(t, games["1"].Game, game)
+	if games["2"].Game != nil {
+		t.Fatalf("expected game 2 to be missing, got %v", games["2"].Game)
+	}
+}
@@ -0,0 +1,168 @@
+package twitch
+
+import (
+	"sync"
+	"time"
+)
+
+// tracker keeps track of which broadcasters are live, by user id
+// only the poller changes it, but it's safe to read from any goroutine
+type tracker struct {
+	mu       sync.RWMutex
+	streams  map[string]*ChannelData
+	sessions map[string]*Session
+	// when a broadcaster went offline, while within the grace window
+	offline map[string]time.Time
+}
+
+func newTracker() *tracker {
+	return &tracker{
+		streams:  map[string]*ChannelData{},
+		sessions: map[string]*Session{},
+		offline:  map[string]time.Time{},
+	}
+}
+
+// load replaces the tracked streams with ones from the database
+func (t *tracker) load(streams map[string]*LiveStream) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.streams = map[string]*ChannelData{}
+	t.sessions = map[string]*Session{}
+	t.offline = map[string]time.Time{}
+	for i, e := range streams {
+		t.streams[i] = e.Stream
+		t.sessions[i] = e.Session
+		if !e.OfflineSince.IsZero() {
+			t.offline[i] = e.OfflineSince
+		}
+	}
+}
+
+// snapshot returns a copy of every tracked stream to store in the database
+func (t *tracker) snapshot() map[string]*LiveStream {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	streams := make(map[string]*LiveStream, len(t.streams))
+	for i, e := range t.streams {
+		stream := &LiveStream{Stream: e, OfflineSince: t.offline[i]}
+		if s, ok := t.sessions[i]; ok {
+			stream.Session = s.copy()
+		}
+		streams[i] = stream
+	}
+
+	return streams
+}
+
+// live returns a copy of the live streams
+func (t *tracker) live() map[string]*ChannelData {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	live := make(map[string]*ChannelData, len(t.streams))
+	for i, e := range t.streams {
+		live[i] = e
+	}
+
+	return live
+}
+
+// get returns a broadcaster's stream as of the last poll, and when
+// they went offline if they're within the grace window
+func (t *tracker) get(userID string) (stream *ChannelData, offlineSince time.Time, ok bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	stream, ok = t.streams[userID]
+	return stream, t.offline[userID], ok
+}
+
+// start begins tracking a new stream
+func (t *tracker) start(channel *ChannelData) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.streams[channel.UserID] = channel
+	t.sessions[channel.UserID] = newSession(channel)
+	delete(t.offline, channel.UserID)
+}
+
+// update records a poll of a stream that's already tracked
+// and returns a copy of its session
+func (t *tracker) update(channel *ChannelData) *Session {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.streams[channel.UserID] = channel
+	delete(t.offline, channel.UserID)
+
+	session, ok := t.sessions[channel.UserID]
+	if ok {
+		session.sample(channel)
+	} else {
+		session = newSession(channel)
+		t.sessions[channel.UserID] = session
+	}
+
+	return session.copy()
+}
+
+// wentOffline records when a broadcaster went offline, if it isn't already recorded
+// it returns when they went offline
+func (t *tracker) wentOffline(userID string, at time.Time) time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if since, ok := t.offline[userID]; ok {
+		return since
+	}
+
+	t.offline[userID] = at
+	return at
+}
+
+// end stops tracking a broadcaster's stream and returns its session
+func (t *tracker) end(userID string) (*Session, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	session, ok := t.sessions[userID]
+	delete(t.streams, userID)
+	delete(t.sessions, userID)
+	delete(t.offline, userID)
+
+	return session, ok
+}
+
+// notify records that subscribers are being sent the current title and game
+// of a stream, as long as they changed and the cooldown has passed
+// it returns the title and game subscribers were last sent
+func (t *tracker) notify(channel *ChannelData, cooldown time.Duration) (prevTitle, prevGame string, ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	session, tracked := t.sessions[channel.UserID]
+	if !tracked {
+		return "", "", false
+	}
+
+	if session.NotifiedAt.IsZero() {
+		session.notified(channel)
+		return "", "", false
+	}
+
+	if session.NotifiedTitle == channel.Title && session.NotifiedGame == channel.GameID {
+		return "", "", false
+	}
+
+	if time.Since(session.NotifiedAt) < cooldown {
+		return "", "", false
+	}
+
+	prevTitle, prevGame = session.NotifiedTitle, session.NotifiedGame
+	session.notified(channel)
+	return prevTitle, prevGame, true
+}
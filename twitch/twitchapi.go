@@ -1,11 +1,15 @@
 package twitch
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -13,6 +17,8 @@ import (
 type ChannelData struct {
 	ID           string    `json:"id"`
 	UserID       string    `json:"user_id"`
+	UserLogin    string    `json:"user_login"`
+	UserName     string    `json:"user_name"`
 	GameID       string    `json:"game_id"`
 	Type         string    `json:"type"`
 	Title        string    `json:"title"`
@@ -22,6 +28,14 @@ type ChannelData struct {
 	ThumbnailURL string    `json:"thumbnail_url"`
 }
 
+// Login returns the twitch username of the streamer
+func (c *ChannelData) Login() string {
+	if c.UserLogin != "" {
+		return c.UserLogin
+	}
+	return strings.ToLower(c.UserName)
+}
+
 // StreamsResponse ..
 type StreamsResponse struct {
 	Data []*ChannelData `json:"data"`
@@ -61,26 +75,109 @@ type GamesResponse struct {
 type Twitch struct {
 	client   http.Client
 	ClientID string
+	// used to get an app access token, requests aren't authorized without it
+	ClientSecret string
+	// oauth endpoint app access tokens are requested from
+	TokenURL string
+	// base url helix requests are made to
+	HelixURL string
+	Events   *Events
+
+	db Storage
+	// where users and games are cached, db unless set
+	Cache Cache
+	// used for discord webhooks, separately from helix requests
+	webhooks http.Client
+
+	// how often twitch is polled
+	UpdateInterval time.Duration
+
+	// how long a broadcaster has to be offline before their
+	// stream is considered over and going live is announced again
+	LiveGrace time.Duration
+	// minimum time between title and game change messages for a stream
+	UpdateCooldown time.Duration
+	// how many polls go live messages are refreshed after, 0 to never refresh
+	RefreshPolls int
+	// how many batches of channels are requested at once
+	PollWorkers int
+
+	// how long cached users and games are used before they're requested again
+	UserCacheTTL time.Duration
+	GameCacheTTL time.Duration
+	// how long ids twitch doesn't know of are remembered for
+	MissingCacheTTL time.Duration
+
+	limit rateLimit
+	live  *tracker
 
-	live map[string]string
+	tokenMu sync.Mutex
+	token   *AppToken
+	// the app token request in flight, if any
+	tokenCall *tokenCall
+
+	// stops Run, and waits for it and for every delivery
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	// passed to every delivery, and cancelled if Shutdown stops waiting for them
+	sending     context.Context
+	stopSending context.CancelFunc
 }
 
-var API *Twitch
+const (
+	// DefaultLiveGrace is the grace window used unless one is configured
+	DefaultLiveGrace = 10 * time.Minute
+	// DefaultUpdateCooldown is the update cooldown used unless one is configured
+	DefaultUpdateCooldown = 5 * time.Minute
+	// DefaultRefreshPolls is how often go live messages are refreshed unless configured
+	DefaultRefreshPolls = 5
+	// DefaultPollWorkers is how many batches are requested at once unless configured
+	DefaultPollWorkers = 4
+	// DefaultUserCacheTTL is how long users are cached unless configured
+	DefaultUserCacheTTL = 24 * time.Hour
+	// DefaultGameCacheTTL is how long games are cached unless configured
+	DefaultGameCacheTTL = 7 * 24 * time.Hour
+	// DefaultMissingCacheTTL is how long unknown ids are cached unless configured
+	DefaultMissingCacheTTL = time.Hour
+
+	// webhookTimeout is how long sending a single discord message can take
+	webhookTimeout = 10 * time.Second
+)
 
-// NewAPI ...
-func NewAPI(clientID string) *Twitch {
-	API = &Twitch{
-		client:   http.Client{},
-		ClientID: clientID,
-		live:     map[string]string{},
+// NewAPI returns a twitch client that stores its subscriptions and cache in db
+func NewAPI(clientID string, db Storage) *Twitch {
+	sending, stopSending := context.WithCancel(context.Background())
+	return &Twitch{
+		client:          http.Client{},
+		ClientID:        clientID,
+		TokenURL:        DefaultTokenURL,
+		HelixURL:        DefaultHelixURL,
+		Events:          NewEvents(),
+		LiveGrace:       DefaultLiveGrace,
+		UpdateCooldown:  DefaultUpdateCooldown,
+		RefreshPolls:    DefaultRefreshPolls,
+		PollWorkers:     DefaultPollWorkers,
+		UserCacheTTL:    DefaultUserCacheTTL,
+		GameCacheTTL:    DefaultGameCacheTTL,
+		MissingCacheTTL: DefaultMissingCacheTTL,
+		db:              db,
+		Cache:           db,
+		webhooks:        http.Client{Timeout: webhookTimeout},
+		live:            newTracker(),
+		sending:         sending,
+		stopSending:     stopSending,
 	}
-	return API
 }
 
 // RequestChannels requests a list of channels
 func (t *Twitch) RequestChannels(channels []string) (*StreamsResponse, error) {
+	return t.requestChannels(context.Background(), channels)
+}
+
+func (t *Twitch) requestChannels(ctx context.Context, channels []string) (*StreamsResponse, error) {
 	channelData := new(StreamsResponse)
-	err := t.request("GET", channelsEndpoint(channels), channelData)
+	err := t.requestContext(ctx, "GET", channelsEndpoint(t.HelixURL, channels), channelData)
 	if err != nil {
 		return nil, err
 	}
@@ -88,58 +185,274 @@ func (t *Twitch) RequestChannels(channels []string) (*StreamsResponse, error) {
 	return channelData, nil
 }
 
+// lookupBatchSize is the most ids helix accepts in a single users or games request
+const lookupBatchSize = 100
+
 // GetUserByID polls the twitch api for a user by their id
 func (t *Twitch) GetUserByID(id string) (*UserData, error) {
-	user := new(UsersResponse)
-	err := t.request("GET", userEndpoint(id), user)
+	users, err := t.GetUsersByID([]string{id})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(user.Data) < 1 {
+	if len(users) < 1 {
 		return nil, errors.New("no data returned")
 	}
 
-	return user.Data[0], nil
+	return users[0], nil
+}
+
+// GetUsersByID polls the twitch api for users by their ids, 100 at a time
+// users that don't exist are left out
+func (t *Twitch) GetUsersByID(ids []string) ([]*UserData, error) {
+	return t.getUsersByID(context.Background(), ids)
 }
 
-func gamesEndpoint(id string) string {
-	return "https://api.twitch.tv/helix/games?id=" + id
+func (t *Twitch) getUsersByID(ctx context.Context, ids []string) ([]*UserData, error) {
+	var users []*UserData
+	for len(ids) > 0 {
+		n := lookupBatchSize
+		if len(ids) < n {
+			n = len(ids)
+		}
+
+		res := new(UsersResponse)
+		err := t.requestContext(ctx, "GET", usersEndpoint(t.HelixURL, ids[:n]), res)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, res.Data...)
+		ids = ids[n:]
+	}
+
+	return users, nil
 }
 
 // GetGameByID ...
 func (t *Twitch) GetGameByID(id string) (*GameData, error) {
-	game := new(GamesResponse)
-	err := t.request("GET", gamesEndpoint(id), game)
+	if id == "" {
+		return unknownGame(), nil
+	}
+
+	games, err := t.GetGamesByID([]string{id})
 	if err != nil {
 		return nil, err
 	}
 
-	if game == nil {
+	if len(games) < 1 {
 		fmt.Println("no game data returned for game", id)
-		return &GameData{"undefined", "undefined", "undefined"}, nil
+		return unknownGame(), nil
+	}
+
+	return games[0], nil
+}
+
+// GetGamesByID polls the twitch api for games by their ids, 100 at a time
+// games that don't exist are left out
+func (t *Twitch) GetGamesByID(ids []string) ([]*GameData, error) {
+	return t.getGamesByID(context.Background(), ids)
+}
+
+func (t *Twitch) getGamesByID(ctx context.Context, ids []string) ([]*GameData, error) {
+	var games []*GameData
+	for len(ids) > 0 {
+		n := lookupBatchSize
+		if len(ids) < n {
+			n = len(ids)
+		}
+
+		res := new(GamesResponse)
+		err := t.requestContext(ctx, "GET", gamesEndpoint(t.HelixURL, ids[:n]), res)
+		if err != nil {
+			return nil, err
+		}
+
+		games = append(games, res.Data...)
+		ids = ids[n:]
 	}
 
-	if len(game.Data) < 1 {
+	return games, nil
+}
+
+// unknownGame is used in place of games twitch doesn't return
+func unknownGame() *GameData {
+	return &GameData{"undefined", "undefined", "undefined"}
+}
+
+// getUser returns a twitch user by their id
+// it tries to see if the user is cached and if
+// not calls the twitch api and caches response
+func (t *Twitch) getUser(ctx context.Context, id string) (*UserData, error) {
+	users, err := t.getUsers(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	user, ok := users[id]
+	if !ok {
+		return nil, errors.New("no data returned")
+	}
+
+	return user, nil
+}
+
+// getUsers returns twitch users by their ids, leaving out ones twitch doesn't know of
+// only the users that aren't cached or have expired are requested, in as few requests
+// as possible. if twitch can't be reached expired users are used instead
+func (t *Twitch) getUsers(ctx context.Context, ids []string) (map[string]*UserData, error) {
+	cached, err := t.Cache.GetCachedUsers(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	users := map[string]*UserData{}
+	stale := map[string]*UserData{}
+	var missing []string
+	for _, e := range ids {
+		c, ok := cached[e]
+		switch {
+		case !ok:
+			missing = append(missing, e)
+		case c.User == nil:
+			if time.Since(c.CachedAt) >= t.MissingCacheTTL {
+				missing = append(missing, e)
+			}
+		case time.Since(c.CachedAt) >= t.UserCacheTTL:
+			stale[e] = c.User
+			missing = append(missing, e)
+		default:
+			users[e] = c.User
+		}
+	}
+	if len(missing) == 0 {
+		return users, nil
+	}
+
+	fetched, err := t.getUsersByID(ctx, missing)
+	if err != nil {
+		if len(stale) == 0 {
+			return nil, err
+		}
+
+		fmt.Println("error getting users, using cached ones:", err.Error())
+		for i, e := range stale {
+			users[i] = e
+		}
+		return users, nil
+	}
+
+	for _, e := range fetched {
+		users[e.ID] = e
+	}
+
+	var unknown []string
+	for _, e := range missing {
+		if _, ok := users[e]; !ok {
+			unknown = append(unknown, e)
+		}
+	}
+
+	// the users are still usable if they can't be cached
+	if err := t.Cache.CacheUsers(fetched, unknown); err != nil {
+		fmt.Println("error caching users:", err.Error())
+	}
+	return users, nil
+}
+
+// getGame returns a twitch game by it's id
+// it tries to see if the game is cached and if
+// not calls the twitch api and caches response
+func (t *Twitch) getGame(ctx context.Context, id string) (*GameData, error) {
+	if id == "" {
+		return unknownGame(), nil
+	}
+
+	games, err := t.getGames(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	game, ok := games[id]
+	if !ok {
 		fmt.Println("no game data returned for game", id)
-		return &GameData{"undefined", "undefined", "undefined"}, nil
+		return unknownGame(), nil
 	}
 
-	return game.Data[0], nil
+	return game, nil
 }
 
-func (t *Twitch) request(method, url string, model interface{}) error {
-	req, err := http.NewRequest(
-		method,
-		url,
-		nil,
-	)
+// getGames returns twitch games by their ids, leaving out ones twitch doesn't know of
+// only the games that aren't cached or have expired are requested, in as few requests
+// as possible. if twitch can't be reached expired games are used instead
+func (t *Twitch) getGames(ctx context.Context, ids []string) (map[string]*GameData, error) {
+	cached, err := t.Cache.GetCachedGames(ids)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	games := map[string]*GameData{}
+	stale := map[string]*GameData{}
+	var missing []string
+	for _, e := range ids {
+		// streams without a category have an empty game id, there's nothing to request
+		if e == "" {
+			continue
+		}
+
+		c, ok := cached[e]
+		switch {
+		case !ok:
+			missing = append(missing, e)
+		case c.Game == nil:
+			if time.Since(c.CachedAt) >= t.MissingCacheTTL {
+				missing = append(missing, e)
+			}
+		case time.Since(c.CachedAt) >= t.GameCacheTTL:
+			stale[e] = c.Game
+			missing = append(missing, e)
+		default:
+			games[e] = c.Game
+		}
+	}
+	if len(missing) == 0 {
+		return games, nil
+	}
+
+	fetched, err := t.getGamesByID(ctx, missing)
+	if err != nil {
+		if len(stale) == 0 {
+			return nil, err
+		}
+
+		fmt.Println("error getting games, using cached ones:", err.Error())
+		for i, e := range stale {
+			games[i] = e
+		}
+		return games, nil
+	}
+
+	for _, e := range fetched {
+		games[e.ID] = e
 	}
-	req.Header.Add("Client-ID", t.ClientID)
 
-	res, err := t.client.Do(req)
+	var unknown []string
+	for _, e := range missing {
+		if _, ok := games[e]; !ok {
+			unknown = append(unknown, e)
+		}
+	}
+
+	// the games are still usable if they can't be cached
+	if err := t.Cache.CacheGames(fetched, unknown); err != nil {
+		fmt.Println("error caching games:", err.Error())
+	}
+	return games, nil
+}
+
+// requestContext makes a helix request once the rate limit allows it
+func (t *Twitch) requestContext(ctx context.Context, method, url string, model interface{}) error {
+	res, err := t.do(ctx, method, url)
 	if err != nil {
 		return err
 	}
@@ -157,3 +470,131 @@ func (t *Twitch) request(method, url string, model interface{}) error {
 
 	return nil
 }
+
+// RateLimit returns the helix rate limit as of the last response
+// callers making their own requests can use it to pace themselves
+func (t *Twitch) RateLimit() RateLimit {
+	return t.limit.get()
+}
+
+// helixRetries is how many times a rate limited or failed request is retried
+const helixRetries = 3
+
+// HelixError is returned when helix responds with a non 2xx status
+type HelixError struct {
+	StatusCode int    `json:"status"`
+	ErrorText  string `json:"error"`
+	Message    string `json:"message"`
+}
+
+func (e *HelixError) Error() string {
+	return fmt.Sprintf("helix responded %d %s: %s", e.StatusCode, e.ErrorText, e.Message)
+}
+
+// Temporary reports whether the request may succeed if it's sent again
+func (e *HelixError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// do sends an authorized helix request and returns the response if it's 2xx
+// if the app token was rejected it's replaced and the request is sent again,
+// and rate limited or failed requests are retried with backoff
+func (t *Twitch) do(ctx context.Context, method, url string) (*http.Response, error) {
+	reauthed := false
+	for attempt := 0; ; attempt++ {
+		token, err := t.appToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = t.limit.wait(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequest(
+			method,
+			url,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		req = req.WithContext(ctx)
+		req.Header.Add("Client-ID", t.ClientID)
+		if token != "" {
+			req.Header.Add("Authorization", "Bearer "+token)
+		}
+
+		res, err := t.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		t.limit.update(res.Header)
+
+		if res.StatusCode >= 200 && res.StatusCode < 300 {
+			return res, nil
+		}
+
+		herr := helixError(res)
+		switch {
+		case res.StatusCode == http.StatusUnauthorized && token != "" && !reauthed:
+			reauthed = true
+			t.invalidateToken(token)
+			continue
+
+		case res.StatusCode == http.StatusTooManyRequests && attempt < helixRetries:
+			// the next request waits for Ratelimit-Reset
+			t.limit.exhausted()
+			continue
+
+		case herr.Temporary() && attempt < helixRetries:
+			err = sleep(ctx, backoff(attempt))
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		return nil, herr
+	}
+}
+
+// helixError reads the error from a non 2xx helix response and closes its body
+func helixError(res *http.Response) *HelixError {
+	defer res.Body.Close()
+
+	herr := &HelixError{}
+	body, err := ioutil.ReadAll(res.Body)
+	if err == nil {
+		json.Unmarshal(body, herr)
+	}
+
+	// the status is what matters, even if the body isn't a helix error
+	herr.StatusCode = res.StatusCode
+	if herr.ErrorText == "" {
+		herr.ErrorText = http.StatusText(res.StatusCode)
+	}
+
+	return herr
+}
+
+// backoff returns how long to wait before retrying a failed request
+// it doubles every attempt starting from half a second, with up to 50% jitter
+func backoff(attempt int) time.Duration {
+	d := 500 * time.Millisecond << uint(attempt)
+	return d + time.Duration(rand.Int63n(int64(d/2)))
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -1,85 +1,341 @@
 package twitch
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"math/rand"
-	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 	humanize "github.com/dustin/go-humanize"
 )
 
-var db *Database
-var client = http.Client{}
-var updateInterval time.Duration
+// Run polls twitch every UpdateInterval until ctx is done or Shutdown is called
+func (t *Twitch) Run(ctx context.Context) error {
+	if t.UpdateInterval <= 0 {
+		return errors.New("update interval must be positive")
+	}
+
+	t.mu.Lock()
+	ctx, t.cancel = context.WithCancel(ctx)
+	t.mu.Unlock()
+
+	t.wg.Add(1)
+	defer t.wg.Done()
 
-// Open ...
-func (t *Twitch) Open(twitchdb *Database, interval int64) {
-	fmt.Println("open")
-	db = twitchdb
-	updateInterval = time.Duration(interval) * time.Second
-	ticker := time.NewTicker(updateInterval)
+	// streams that were already announced before a restart
+	// shouldn't be announced again
+	streams, err := t.db.GetLiveStreams()
+	if err != nil {
+		fmt.Println("error getting live streams:", err.Error())
+	}
+	t.live.load(streams)
 
-	t.checkForUpdates()
+	ticker := time.NewTicker(t.UpdateInterval)
+	defer ticker.Stop()
+
+	t.checkForUpdates(ctx)
 	for {
 		select {
 		case <-ticker.C:
-			t.checkForUpdates()
+			t.checkForUpdates(ctx)
+		case <-ctx.Done():
+			return nil
 		}
 	}
 }
 
+// Shutdown stops polling and waits for messages that are still
+// being sent to discord. once ctx is done the messages are cancelled,
+// and it only returns after they've stopped so the database can be closed
+func (t *Twitch) Shutdown(ctx context.Context) error {
+	t.mu.Lock()
+	if t.cancel != nil {
+		t.cancel()
+	}
+	t.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		t.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		t.stopSending()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// deliver runs f in the background, and Shutdown waits for it to finish
+// f should stop once its context is done
+// it can only be called by the poller or by something it delivers
+func (t *Twitch) deliver(f func(ctx context.Context)) {
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		f(t.sending)
+	}()
+}
+
 // CheckForUpdates checks
-func (t *Twitch) checkForUpdates() {
-	channels, err := db.GetAllTwitchChannels()
+func (t *Twitch) checkForUpdates(ctx context.Context) {
+	channels, err := t.db.GetAllTwitchChannels()
 	if err != nil {
 		fmt.Println("Error getting channels", err.Error())
 		return
 	}
-	liveCopy := copyMap(t.live)
+	liveCopy := t.live.live()
 
-	for len(channels) > 100 {
-		res, err := t.RequestChannels(channels[:100])
-		if err != nil {
-			fmt.Println(err.Error())
-			return
+	// the poll has to finish before the next one starts
+	ctx, cancel := context.WithTimeout(ctx, t.UpdateInterval)
+	defer cancel()
+
+	failed := map[string]bool{}
+	var streams []*ChannelData
+	for res := range t.pollChannels(ctx, channels) {
+		if res.err != nil {
+			fmt.Printf("error requesting %d channels: %s\n", len(res.logins), res.err)
+			for _, e := range res.logins {
+				failed[normalizeName(e)] = true
+			}
+			continue
+		}
+
+		streams = append(streams, res.streams...)
+	}
+
+	t.prefetch(ctx, streams)
+	for _, e := range streams {
+		t.handleStream(e, liveCopy)
+	}
+
+	// nothing is known about channels in a failed batch, so they're left as they were
+	for i, e := range liveCopy {
+		if failed[e.Login()] {
+			delete(liveCopy, i)
+		}
+	}
+
+	now := time.Now()
+	for i, e := range liveCopy {
+		// wait out the grace window in case the broadcaster is only reconnecting
+		since := t.live.wentOffline(i, now)
+		if since.Equal(now) && t.LiveGrace > 0 {
+			fmt.Printf("%s went offline, waiting %s before ending the stream\n", e.Login(), t.LiveGrace)
+		}
+
+		if now.Sub(since) < t.LiveGrace {
+			continue
+		}
+
+		t.endStream(i, e)
+	}
+
+	t.saveLive()
+}
+
+// prefetch caches the user and game of every stream in as few requests
+// as possible, so sending notifications only has to read from the cache
+func (t *Twitch) prefetch(ctx context.Context, streams []*ChannelData) {
+	var users, games []string
+	seen := map[string]bool{}
+	for _, e := range streams {
+		if !seen["user:"+e.UserID] {
+			seen["user:"+e.UserID] = true
+			users = append(users, e.UserID)
+		}
+		if e.GameID != "" && !seen["game:"+e.GameID] {
+			seen["game:"+e.GameID] = true
+			games = append(games, e.GameID)
 		}
+	}
+
+	if _, err := t.getUsers(ctx, users); err != nil {
+		fmt.Println("error getting users:", err.Error())
+	}
+	if _, err := t.getGames(ctx, games); err != nil {
+		fmt.Println("error getting games:", err.Error())
+	}
+}
+
+// pollBatchSize is the most channels helix returns in a single request
+const pollBatchSize = 100
+
+// pollRetries is how many times a failed batch is retried within a poll
+const pollRetries = 3
+
+// batchResult is the outcome of requesting a single batch of channels
+type batchResult struct {
+	logins  []string
+	streams []*ChannelData
+	err     error
+}
 
-		for _, e := range res.Data {
-			if _, ok := t.live[e.ID]; !ok {
-				t.live[e.ID] = ""
-				go sendChannelLive(e)
-			} else {
-				delete(liveCopy, e.ID)
+// pollChannels requests every channel in batches using a pool of workers
+// the returned channel is closed once every batch is done
+func (t *Twitch) pollChannels(ctx context.Context, channels []string) <-chan *batchResult {
+	batches := make(chan []string)
+	results := make(chan *batchResult)
+
+	workers := t.PollWorkers
+	if workers < 1 {
+		workers = 1
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for b := range batches {
+				results <- t.pollBatch(ctx, b)
 			}
+		}()
+	}
+
+	go func() {
+		for len(channels) > 0 {
+			n := pollBatchSize
+			if len(channels) < n {
+				n = len(channels)
+			}
+
+			batches <- channels[:n]
+			channels = channels[n:]
 		}
+		close(batches)
 
-		channels = channels[100:]
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+// pollBatch requests a single batch of channels, retrying until it
+// succeeds, runs out of retries or the poll runs out of time
+// helix errors are already retried by the client, so only network errors are retried here
+func (t *Twitch) pollBatch(ctx context.Context, logins []string) *batchResult {
+	res := &batchResult{logins: logins}
+
+	for attempt := 0; attempt <= pollRetries; attempt++ {
+		if attempt > 0 {
+			if sleep(ctx, backoff(attempt-1)) != nil {
+				return res
+			}
+		}
+
+		var data *StreamsResponse
+		data, res.err = t.requestChannels(ctx, logins)
+		if res.err == nil {
+			res.streams = data.Data
+			return res
+		}
+
+		if _, ok := res.err.(*HelixError); ok {
+			return res
+		}
 	}
 
-	res, err := t.RequestChannels(channels)
+	return res
+}
+
+// endStream stops tracking a broadcaster's stream and sends out that it ended
+func (t *Twitch) endStream(userID string, channel *ChannelData) {
+	if session, ok := t.live.end(userID); ok {
+		t.deliver(func(ctx context.Context) { t.sendChannelOffline(ctx, channel, session) })
+		t.deliver(func(ctx context.Context) { t.endLiveMessages(ctx, channel, session) })
+	}
+	t.publish(EventOffline, channel, nil)
+}
+
+// saveLive stores the live streams so they survive a restart
+func (t *Twitch) saveLive() {
+	err := t.db.SetLiveStreams(t.live.snapshot())
 	if err != nil {
-		fmt.Println(err.Error())
-		return
+		fmt.Println("error saving live streams:", err.Error())
 	}
+}
 
-	for _, e := range res.Data {
-		if _, ok := t.live[e.ID]; !ok {
-			t.live[e.ID] = ""
-			go sendChannelLive(e)
+// handleStream handles a single stream returned from a poll
+// any broadcaster still live is removed from liveCopy
+func (t *Twitch) handleStream(channel *ChannelData, liveCopy map[string]*ChannelData) {
+	// streams are tracked by broadcaster, since twitch gives
+	// a stream a new id every time the broadcaster reconnects
+	prev, since, ok := t.live.get(channel.UserID)
+	delete(liveCopy, channel.UserID)
+
+	// a different id or start time means it's a new stream
+	if ok && (prev.ID != channel.ID || !prev.StartedAt.Equal(channel.StartedAt)) {
+		if t.LiveGrace <= 0 {
+			t.endStream(channel.UserID, prev)
+			ok = false
 		} else {
-			delete(liveCopy, e.ID)
+			last := "between polls"
+			if !since.IsZero() {
+				last = humanize.Time(since)
+			}
+			fmt.Printf("not announcing %s going live again, they were live %s which is within the %s grace window\n", channel.Login(), last, t.LiveGrace)
 		}
 	}
 
-	for i := range liveCopy {
-		delete(t.live, i)
+	if !ok {
+		t.live.start(channel)
+		t.deliver(func(ctx context.Context) { t.sendChannelLive(ctx, channel) })
+		t.publish(EventOnline, channel, nil)
+		return
+	}
+
+	session := t.live.update(channel)
+	if prev.Title != channel.Title || prev.GameID != channel.GameID {
+		t.publish(EventUpdated, channel, prev)
+	}
+
+	// changes within the update cooldown are held back and only the
+	// latest state is sent once the cooldown has passed
+	if prevTitle, prevGame, ok := t.live.notify(channel, t.UpdateCooldown); ok {
+		t.deliver(func(ctx context.Context) { t.sendChannelUpdated(ctx, channel, prevTitle, prevGame) })
+	}
+
+	if t.RefreshPolls > 0 && session.Samples%t.RefreshPolls == 0 {
+		t.deliver(func(ctx context.Context) { t.refreshLiveMessages(ctx, channel) })
+	}
+}
+
+// publish sends a stream event to anyone watching for it
+func (t *Twitch) publish(typ EventType, channel, previous *ChannelData) {
+	if !t.Events.Watched() {
+		return
+	}
+
+	login := channel.Login()
+	owners, err := t.db.GetOwnersByTwitchName(login)
+	if err != nil {
+		fmt.Println("error getting subscription owners:", err.Error())
 	}
+
+	channels := make([]string, 0, len(owners))
+	for i := range owners {
+		channels = append(channels, i)
+	}
+
+	t.Events.Publish(&Event{
+		Type:     typ,
+		Login:    login,
+		Channels: channels,
+		Stream:   channel,
+		Previous: previous,
+		owners:   owners,
+	})
 }
 
 const (
@@ -89,9 +345,16 @@ const (
 	letterIdxMax  = 63 / letterIdxBits   // # of letter indices fitting in 63 bits
 )
 
-var src = rand.NewSource(time.Now().UnixNano())
+var (
+	// sources aren't safe for concurrent use, and messages are built in many goroutines
+	srcMu sync.Mutex
+	src   = rand.NewSource(time.Now().UnixNano())
+)
 
 func randStringBytes(n int) string {
+	srcMu.Lock()
+	defer srcMu.Unlock()
+
 	b := make([]byte, n)
 	// src.Int63() generates 63 random bits, enough for letterIdxMax characters
 	for i, cache, remain := n-1, src.Int63(), letterIdxMax; i >= 0; {
@@ -109,30 +372,20 @@ func randStringBytes(n int) string {
 	return string(b)
 }
 
-func copyMap(src map[string]string) map[string]string {
-	dst := map[string]string{}
-
-	for k, v := range src {
-		dst[k] = v
-	}
-
-	return dst
-}
-
-func sendChannelLive(channel *ChannelData) {
-	user, err := db.GetUserByID(channel.UserID)
+func (t *Twitch) sendChannelLive(ctx context.Context, channel *ChannelData) {
+	user, err := t.getUser(ctx, channel.UserID)
 	if err != nil {
 		fmt.Println("error getting user by id:", err.Error())
 		return
 	}
 
-	game, err := db.GetGameByID(channel.GameID)
+	game, err := t.getGame(ctx, channel.GameID)
 	if err != nil {
 		fmt.Println("error getting game by id:", err.Error())
 		return
 	}
 
-	webhooks, err := db.GetWebhooksByTwitchName(user.Login)
+	webhooks, err := t.db.GetWebhooksByTwitchName(user.Login)
 	if err != nil {
 		fmt.Println("error getting webhooks:", err.Error())
 		return
@@ -141,12 +394,29 @@ func sendChannelLive(channel *ChannelData) {
 	fmt.Println("total webhooks:", len(webhooks))
 
 	for _, e := range webhooks {
-		go executeWebook(e, user, channel, game)
+		webhook := e
+		t.deliver(func(ctx context.Context) { t.executeWebook(ctx, webhook, user, channel, game) })
 	}
 }
 
-func executeWebook(webhook *Webhook, user *UserData, channel *ChannelData, game *GameData) {
-	data := discordgo.WebhookParams{
+func (t *Twitch) executeWebook(ctx context.Context, webhook *Webhook, user *UserData, channel *ChannelData, game *GameData) {
+	id, err := t.sendWebhook(ctx, webhook, "", liveMessage(user, channel, game))
+	if err != nil {
+		fmt.Println("error sending go live message:", err.Error())
+		return
+	}
+
+	// keep the message id around so the message can be kept up to date
+	err = t.db.SetLiveMessage(user.Login, webhook.Channel, id)
+	if err != nil {
+		fmt.Println("error storing go live message:", err.Error())
+	}
+}
+
+// liveMessage is the message sent when a stream goes live
+// it's also used to refresh the message while the stream is live
+func liveMessage(user *UserData, channel *ChannelData, game *GameData) *discordgo.WebhookParams {
+	return &discordgo.WebhookParams{
 		Embeds: []*discordgo.MessageEmbed{
 			&discordgo.MessageEmbed{
 				URL:         "https://twitch.tv/" + user.Login,
@@ -155,7 +425,7 @@ func executeWebook(webhook *Webhook, user *UserData, channel *ChannelData, game
 				Author: &discordgo.MessageEmbedAuthor{
 					URL:     "https://twitch.tv",
 					Name:    "Twitch",
-					IconURL: "https://cdn.discordapp.com/attachments/272212345340690443/374388819643858955/twitch11.png",
+					IconURL: twitchIcon,
 				},
 				Image: &discordgo.MessageEmbedImage{
 					URL:    strings.Replace(strings.Replace(channel.ThumbnailURL, "{width}", "1280", -1), "{height}", "720", -1) + "?please-do-not-cache-this=" + randStringBytes(15),
@@ -183,36 +453,319 @@ func executeWebook(webhook *Webhook, user *UserData, channel *ChannelData, game
 				},
 			},
 		},
-		Username:  "Twitch",
-		AvatarURL: "https://cdn.discordapp.com/attachments/196118375485669376/419336810431250432/glitch_474x356.png",
+		Username:  webhookUsername,
+		AvatarURL: webhookAvatar,
 	}
+}
 
-	raw, err := json.Marshal(data)
+// refreshLiveMessages edits the go live messages of a stream with its current state
+func (t *Twitch) refreshLiveMessages(ctx context.Context, channel *ChannelData) {
+	messages, err := t.db.GetLiveMessages(channel.Login())
 	if err != nil {
-		fmt.Println("unable to marshal webhook embed:", err.Error())
+		fmt.Println("error getting go live messages:", err.Error())
 		return
 	}
-	req, err := http.NewRequest("POST", webhookEndpoint(webhook.ID, webhook.Token), bytes.NewBuffer(raw))
+
+	if len(messages) == 0 {
+		return
+	}
+
+	user, err := t.getUser(ctx, channel.UserID)
 	if err != nil {
-		fmt.Println("unable to make webhook request:", err.Error())
+		fmt.Println("error getting user by id:", err.Error())
+		return
+	}
+
+	game, err := t.getGame(ctx, channel.GameID)
+	if err != nil {
+		fmt.Println("error getting game by id:", err.Error())
+		return
+	}
+
+	t.editLiveMessages(user, messages, liveMessage(user, channel, game), false)
+}
+
+// endLiveMessages edits the go live messages of a stream to show it ended
+func (t *Twitch) endLiveMessages(ctx context.Context, channel *ChannelData, session *Session) {
+	messages, err := t.db.GetLiveMessages(channel.Login())
+	if err != nil {
+		fmt.Println("error getting go live messages:", err.Error())
+		return
+	}
+
+	if len(messages) == 0 {
 		return
 	}
-	req.Header.Set("Content-Type", "application/json")
 
-	res, err := client.Do(req)
+	user, err := t.getUser(ctx, channel.UserID)
 	if err != nil {
-		fmt.Println("error doing webhook request:", err.Error())
+		fmt.Println("error getting user by id:", err.Error())
 		return
 	}
 
-	switch res.StatusCode {
-	case http.StatusNoContent:
-		fmt.Println("webhook req success")
-	case http.StatusNotFound:
-		fmt.Println("webhook 404'd. fixing...")
-		go db.webhook404(webhook)
+	data := &discordgo.WebhookParams{
+		Embeds: []*discordgo.MessageEmbed{
+			&discordgo.MessageEmbed{
+				URL:         "https://twitch.tv/" + user.Login,
+				Title:       user.Login + " was live",
+				Description: session.Title,
+				Author: &discordgo.MessageEmbedAuthor{
+					URL:     "https://twitch.tv",
+					Name:    "Twitch",
+					IconURL: twitchIcon,
+				},
+				Thumbnail: &discordgo.MessageEmbedThumbnail{
+					URL: user.ProfileImageURL,
+				},
+				Timestamp: session.StartedAt.Format(time.RFC3339),
+				Fields: []*discordgo.MessageEmbedField{
+					&discordgo.MessageEmbedField{
+						Name:   "Duration",
+						Value:  session.Duration().Round(time.Minute).String(),
+						Inline: true,
+					},
+					&discordgo.MessageEmbedField{
+						Name:   "Peak Viewers",
+						Value:  strconv.Itoa(session.PeakViewers),
+						Inline: true,
+					},
+					&discordgo.MessageEmbedField{
+						Name:  "Games",
+						Value: strings.Join(t.gameNames(ctx, session.Games), ", "),
+					},
+				},
+				Footer: &discordgo.MessageEmbedFooter{
+					Text: "Stream ended " + humanize.Time(session.LastSeen),
+				},
+			},
+		},
+	}
+	if user.OfflineImageURL != "" {
+		data.Embeds[0].Image = &discordgo.MessageEmbedImage{URL: user.OfflineImageURL}
+	}
+
+	t.editLiveMessages(user, messages, data, true)
+}
+
+// editLiveMessages edits every go live message of a twitch channel
+// messages are no longer tracked once they're ended or can't be edited anymore
+func (t *Twitch) editLiveMessages(user *UserData, messages map[string]string, data *discordgo.WebhookParams, ended bool) {
+	webhooks, err := t.db.GetWebhooksByTwitchName(user.Login)
+	if err != nil {
+		fmt.Println("error getting webhooks:", err.Error())
+		return
+	}
+
+	for _, e := range webhooks {
+		id, ok := messages[e.Channel]
+		if !ok {
+			continue
+		}
+
+		webhook, id := e, id
+		t.deliver(func(ctx context.Context) {
+			_, err := t.sendWebhook(ctx, webhook, id, data)
+			if err != nil {
+				fmt.Println("error editing go live message:", err.Error())
+			}
+
+			if ended || err == errUnknownMessage {
+				err = t.db.DeleteLiveMessage(user.Login, webhook.Channel, id)
+				if err != nil {
+					fmt.Println("error deleting go live message:", err.Error())
+				}
+			}
+		})
+	}
+}
+
+// gameNames returns the names of games from their ids
+func (t *Twitch) gameNames(ctx context.Context, ids []string) []string {
+	games := make([]string, 0, len(ids))
+	for _, e := range ids {
+		game, err := t.getGame(ctx, e)
+		if err != nil {
+			fmt.Println("error getting game by id:", err.Error())
+			continue
+		}
+		games = append(games, game.Name)
+	}
+	if len(games) == 0 {
+		games = append(games, "none")
+	}
+
+	return games
+}
+
+// sendChannelOffline sends a summary of a stream that just ended
+// to every discord channel that has offline summaries enabled
+func (t *Twitch) sendChannelOffline(ctx context.Context, channel *ChannelData, session *Session) {
+	webhooks, err := t.db.GetWebhooksByTwitchName(channel.Login())
+	if err != nil {
+		fmt.Println("error getting webhooks:", err.Error())
+		return
+	}
+
+	var enabled []*Webhook
+	for _, e := range webhooks {
+		opts, err := t.db.GetChannelOptions(e.Channel)
+		if err != nil {
+			fmt.Println("error getting channel options:", err.Error())
+			continue
+		}
+
+		if opts.OfflineSummary {
+			enabled = append(enabled, e)
+		}
+	}
+
+	if len(enabled) == 0 {
+		return
+	}
+
+	user, err := t.getUser(ctx, channel.UserID)
+	if err != nil {
+		fmt.Println("error getting user by id:", err.Error())
+		return
+	}
+
+	games := t.gameNames(ctx, session.Games)
+
+	data := discordgo.WebhookParams{
+		Embeds: []*discordgo.MessageEmbed{
+			&discordgo.MessageEmbed{
+				URL:         "https://twitch.tv/" + user.Login,
+				Title:       user.Login + " went offline",
+				Description: session.Title,
+				Author: &discordgo.MessageEmbedAuthor{
+					URL:     "https://twitch.tv",
+					Name:    "Twitch",
+					IconURL: twitchIcon,
+				},
+				Thumbnail: &discordgo.MessageEmbedThumbnail{
+					URL: user.ProfileImageURL,
+				},
+				Timestamp: session.LastSeen.Format(time.RFC3339),
+				Fields: []*discordgo.MessageEmbedField{
+					&discordgo.MessageEmbedField{
+						Name:   "Duration",
+						Value:  session.Duration().Round(time.Minute).String(),
+						Inline: true,
+					},
+					&discordgo.MessageEmbedField{
+						Name:   "Peak Viewers",
+						Value:  strconv.Itoa(session.PeakViewers),
+						Inline: true,
+					},
+					&discordgo.MessageEmbedField{
+						Name:   "Average Viewers",
+						Value:  strconv.Itoa(session.AverageViewers()),
+						Inline: true,
+					},
+					&discordgo.MessageEmbedField{
+						Name:  "Games",
+						Value: strings.Join(games, ", "),
+					},
+				},
+				Footer: &discordgo.MessageEmbedFooter{
+					Text: "Stream ended",
+				},
+			},
+		},
+		Username:  webhookUsername,
+		AvatarURL: webhookAvatar,
+	}
+
+	for _, e := range enabled {
+		webhook := e
+		t.deliver(func(ctx context.Context) { t.postWebhook(ctx, webhook, &data) })
+	}
+}
+
+// sendChannelUpdated sends the new title or game of a stream
+// to every subscription that has stream updates enabled
+func (t *Twitch) sendChannelUpdated(ctx context.Context, channel *ChannelData, prevTitle, prevGame string) {
+	webhooks, err := t.db.GetWebhooksByTwitchName(channel.Login())
+	if err != nil {
+		fmt.Println("error getting webhooks:", err.Error())
+		return
+	}
+
+	var enabled []*Webhook
+	for _, e := range webhooks {
+		opts, err := t.db.GetSubscriptionOptions(channel.Login(), e.Channel)
+		if err != nil {
+			fmt.Println("error getting subscription options:", err.Error())
+			continue
+		}
+
+		if opts.StreamUpdates {
+			enabled = append(enabled, e)
+		}
+	}
+
+	if len(enabled) == 0 {
+		return
+	}
+
+	user, err := t.getUser(ctx, channel.UserID)
+	if err != nil {
+		fmt.Println("error getting user by id:", err.Error())
+		return
+	}
+
+	game, err := t.getGame(ctx, channel.GameID)
+	if err != nil {
+		fmt.Println("error getting game by id:", err.Error())
+		return
+	}
+
+	title := user.Login + " changed their title"
+	if channel.GameID != prevGame {
+		title = user.Login + " is now playing " + game.Name
+	}
+
+	fields := []*discordgo.MessageEmbedField{
+		&discordgo.MessageEmbedField{
+			Name:   "Game",
+			Value:  game.Name,
+			Inline: true,
+		},
+	}
+	if channel.Title != prevTitle {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Previous Title",
+			Value: prevTitle,
+		})
+	}
+
+	data := discordgo.WebhookParams{
+		Embeds: []*discordgo.MessageEmbed{
+			&discordgo.MessageEmbed{
+				URL:         "https://twitch.tv/" + user.Login,
+				Title:       title,
+				Description: channel.Title,
+				Author: &discordgo.MessageEmbedAuthor{
+					URL:     "https://twitch.tv",
+					Name:    "Twitch",
+					IconURL: twitchIcon,
+				},
+				Thumbnail: &discordgo.MessageEmbedThumbnail{
+					URL: user.ProfileImageURL,
+				},
+				Timestamp: time.Now().Format(time.RFC3339),
+				Fields:    fields,
+				Footer: &discordgo.MessageEmbedFooter{
+					Text: "Live " + humanize.Time(channel.StartedAt),
+				},
+			},
+		},
+		Username:  webhookUsername,
+		AvatarURL: webhookAvatar,
+	}
 
-	default:
-		fmt.Println("webhook req didnt respond OK, responded", res.Status)
+	for _, e := range enabled {
+		webhook := e
+		t.deliver(func(ctx context.Context) { t.postWebhook(ctx, webhook, &data) })
 	}
 }
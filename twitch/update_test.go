@@ -0,0 +1,211 @@
+package twitch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// fakeHelix serves the helix streams, users and games endpoints, and discord webhooks
+type fakeHelix struct {
+	*httptest.Server
+	started               time.Time
+	live                  int32
+	userReqs, gameReqs    int32
+	posts, edits, unknown int32
+}
+
+func newFakeHelix(t *testing.T) *fakeHelix {
+	f := &fakeHelix{started: time.Now(), live: 1}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.Close)
+
+	// discord webhooks aren't helix requests, so they're redirected separately
+	prev := webhookEndpoint
+	webhookEndpoint = func(id, token string) string { return f.URL + "/webhooks/" + id + "/" + token }
+	t.Cleanup(func() { webhookEndpoint = prev })
+
+	return f
+}
+
+func (f *fakeHelix) serve(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Ratelimit-Remaining", "800")
+	w.Header().Set("Ratelimit-Reset", fmt.Sprint(time.Now().Add(time.Minute).Unix()))
+
+	switch {
+	case r.URL.Path == "/helix/streams":
+		res := &StreamsResponse{}
+		if atomic.LoadInt32(&f.live) == 1 {
+			for _, e := range r.URL.Query()["user_login"] {
+				res.Data = append(res.Data, &ChannelData{
+					ID:        "stream-" + e,
+					UserID:    "id-" + e,
+					UserLogin: e,
+					GameID:    "1",
+					Title:     "title",
+					StartedAt: f.started,
+				})
+			}
+		}
+		json.NewEncoder(w).Encode(res)
+
+	case r.URL.Path == "/helix/users":
+		atomic.AddInt32(&f.userReqs, 1)
+		res := &UsersResponse{}
+		for _, e := range r.URL.Query()["id"] {
+			res.Data = append(res.Data, &UserData{ID: e, Login: strings.TrimPrefix(e, "id-")})
+		}
+		json.NewEncoder(w).Encode(res)
+
+	case r.URL.Path == "/helix/games":
+		atomic.AddInt32(&f.gameReqs, 1)
+		res := &GamesResponse{}
+		for _, e := range r.URL.Query()["id"] {
+			res.Data = append(res.Data, &GameData{ID: e, Name: "game " + e})
+		}
+		json.NewEncoder(w).Encode(res)
+
+	case strings.HasPrefix(r.URL.Path, "/webhooks/") && r.Method == "POST":
+		atomic.AddInt32(&f.posts, 1)
+		json.NewEncoder(w).Encode(map[string]string{"id": "message"})
+
+	case strings.HasPrefix(r.URL.Path, "/webhooks/") && r.Method == "PATCH":
+		atomic.AddInt32(&f.edits, 1)
+		json.NewEncoder(w).Encode(map[string]string{"id": "message"})
+
+	default:
+		atomic.AddInt32(&f.unknown, 1)
+		http.NotFound(w, r)
+	}
+}
+
+// waitFor fails the test if cond isn't true within a few seconds
+func waitFor(t *testing.T, what string, cond func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestPoller(t *testing.T) {
+	helix := newFakeHelix(t)
+	db := openTestDB(t)
+
+	// enough channels for several batches to be polled at once
+	const channels = 250
+	for i := 0; i < channels; i++ {
+		err := db.AddChannel(fmt.Sprintf("streamer%d", i), fmt.Sprintf("%d", i), "", "bot", &Webhook{ID: "hook", Token: "token"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tw := NewAPI("client", db)
+	tw.HelixURL = helix.URL + "/helix"
+	tw.UpdateInterval = 500 * time.Millisecond
+	tw.LiveGrace = 0
+	tw.UpdateCooldown = 0
+	tw.RefreshPolls = 2
+
+	done := make(chan error, 1)
+	go func() { done <- tw.Run(context.Background()) }()
+
+	waitFor(t, "go live messages", func() bool {
+		return atomic.LoadInt32(&helix.posts) == channels
+	})
+	waitFor(t, "live messages to be refreshed", func() bool {
+		return atomic.LoadInt32(&helix.edits) >= channels
+	})
+
+	// every stream has to be announced exactly once, with each user and game
+	// requested only until they're cached
+	if n := len(tw.live.live()); n != channels {
+		t.Fatalf("expected %d live streams, got %d", channels, n)
+	}
+	if n := atomic.LoadInt32(&helix.userReqs); n != 3 {
+		t.Fatalf("expected users to be requested in 3 batches, got %d requests", n)
+	}
+	if n := atomic.LoadInt32(&helix.gameReqs); n != 1 {
+		t.Fatalf("expected the game to be requested once, got %d requests", n)
+	}
+
+	atomic.StoreInt32(&helix.live, 0)
+	waitFor(t, "streams to end", func() bool {
+		return len(tw.live.live()) == 0
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := tw.Shutdown(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	if n := atomic.LoadInt32(&helix.posts); n != channels {
+		t.Fatalf("expected %d go live messages, got %d", channels, n)
+	}
+	if n := atomic.LoadInt32(&helix.unknown); n != 0 {
+		t.Fatalf("%d requests were made to unknown endpoints", n)
+	}
+
+	streams, err := db.GetLiveStreams()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(streams) != 0 {
+		t.Fatalf("expected no live streams to be stored, got %d", len(streams))
+	}
+}
+
+func TestShutdownCancelsDeliveries(t *testing.T) {
+	// discord never responds, so the message is only sent once it's cancelled
+	reached, release := make(chan struct{}), make(chan struct{})
+	hung := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(reached)
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer hung.Close()
+	defer close(release)
+	prev := webhookEndpoint
+	webhookEndpoint = func(id, token string) string { return hung.URL + "/webhooks/" + id + "/" + token }
+	defer func() { webhookEndpoint = prev }()
+
+	tw := NewAPI("client", openTestDB(t))
+	var stopped int32
+	tw.deliver(func(ctx context.Context) {
+		_, err := tw.sendWebhook(ctx, &Webhook{ID: "hook", Token: "token"}, "", &discordgo.WebhookParams{})
+		if err == nil {
+			t.Error("expected the cancelled message to fail")
+		}
+		atomic.StoreInt32(&stopped, 1)
+	})
+	<-reached
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := tw.Shutdown(ctx)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	// the database can be closed once Shutdown returns
+	if atomic.LoadInt32(&stopped) != 1 {
+		t.Fatal("expected the delivery to have stopped before Shutdown returned")
+	}
+}
@@ -0,0 +1,107 @@
+package twitch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	webhookUsername = "Twitch"
+	webhookAvatar   = "https://cdn.discordapp.com/attachments/196118375485669376/419336810431250432/glitch_474x356.png"
+	twitchIcon      = "https://cdn.discordapp.com/attachments/272212345340690443/374388819643858955/twitch11.png"
+)
+
+// discord json error code for a message that doesn't exist
+const discordUnknownMessage = 10008
+
+var (
+	errUnknownMessage = errors.New("webhook message no longer exists")
+	errUnknownWebhook = errors.New("webhook no longer exists")
+)
+
+// postWebhook sends a message to a discord webhook
+func (t *Twitch) postWebhook(ctx context.Context, webhook *Webhook, data *discordgo.WebhookParams) {
+	_, err := t.sendWebhook(ctx, webhook, "", data)
+	if err != nil {
+		fmt.Println("error sending webhook:", err.Error())
+	}
+}
+
+// sendWebhook sends a message to a discord webhook, or edits the message with
+// messageID if it isn't empty, and returns the id of the message
+// webhooks that no longer exist are removed from the database
+func (t *Twitch) sendWebhook(ctx context.Context, webhook *Webhook, messageID string, data *discordgo.WebhookParams) (string, error) {
+	method, url := "POST", webhookEndpoint(webhook.ID, webhook.Token)+"?wait=true"
+	var body interface{} = data
+	if messageID != "" {
+		method, url = "PATCH", webhookMessageEndpoint(webhook.ID, webhook.Token, messageID)
+		// the username and avatar can't be edited
+		body = struct {
+			Embeds []*discordgo.MessageEmbed `json:"embeds"`
+		}{data.Embeds}
+	}
+
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal webhook embed: %s", err)
+	}
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(raw))
+	if err != nil {
+		return "", fmt.Errorf("unable to make webhook request: %s", err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := t.webhooks.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error doing webhook request: %s", err)
+	}
+	defer res.Body.Close()
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error reading webhook response: %s", err)
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		var msg struct {
+			ID string `json:"id"`
+		}
+		if len(resBody) > 0 {
+			err = json.Unmarshal(resBody, &msg)
+			if err != nil {
+				return "", fmt.Errorf("unable to unmarshal webhook message: %s", err)
+			}
+		}
+
+		return msg.ID, nil
+	case http.StatusNotFound:
+		var apiErr struct {
+			Code int `json:"code"`
+		}
+		json.Unmarshal(resBody, &apiErr)
+		if apiErr.Code == discordUnknownMessage {
+			return "", errUnknownMessage
+		}
+
+		fmt.Println("webhook 404'd. fixing...")
+		t.deliver(func(context.Context) {
+			err := t.db.RemoveWebhook(webhook)
+			if err != nil {
+				fmt.Println("error removing webhook:", err.Error())
+			}
+		})
+		return "", errUnknownWebhook
+
+	default:
+		return "", fmt.Errorf("webhook req didnt respond OK, responded %s", res.Status)
+	}
+}